@@ -0,0 +1,41 @@
+package contracts
+
+import (
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/curve"
+)
+
+// contractAddressPrefix is the domain-separator felt used by Starknet when
+// deriving a contract address, encoded as the ASCII string "STARKNET_CONTRACT_ADDRESS".
+var contractAddressPrefix, _ = new(felt.Felt).SetString("0x535441524b4e45545f434f4e54524143545f41444452455353")
+
+// addressBound is 2**251 - 256, the modulus contract addresses are reduced by.
+var addressBound, _ = new(felt.Felt).SetString("0x7ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00")
+
+// PrecomputeAddress computes the Starknet contract address that `DeployAccount`
+// (or the UDC) would assign to a contract before it is actually deployed.
+//
+// It follows the Starknet address derivation rule:
+//
+//	pedersen_hash("STARKNET_CONTRACT_ADDRESS", deployer, salt, classHash, pedersen_array_hash(constructorCalldata)) mod (2**251 - 256)
+//
+// Parameters:
+// - deployerAddress: the address that deploys the contract (zero felt for counterfactual account deployment)
+// - salt: the contract address salt
+// - classHash: the class hash of the contract being deployed
+// - constructorCalldata: the calldata passed to the constructor
+// Returns:
+// - *felt.Felt: the precomputed contract address
+func PrecomputeAddress(deployerAddress, salt, classHash *felt.Felt, constructorCalldata []*felt.Felt) *felt.Felt {
+	calldataHash := curve.PedersenArray(constructorCalldata...)
+
+	address := curve.PedersenArray(
+		contractAddressPrefix,
+		deployerAddress,
+		salt,
+		classHash,
+		calldataHash,
+	)
+
+	return new(felt.Felt).Mod(address, addressBound)
+}
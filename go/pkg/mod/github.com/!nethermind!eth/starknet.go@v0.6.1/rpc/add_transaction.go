@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/NethermindEth/juno/core/felt"
+)
+
+// AddInvokeTransactionResponse is the response of AddInvokeTransaction.
+type AddInvokeTransactionResponse struct {
+	TransactionHash *felt.Felt `json:"transaction_hash"`
+}
+
+// AddDeclareTransactionResponse is the response of AddDeclareTransaction.
+type AddDeclareTransactionResponse struct {
+	TransactionHash *felt.Felt `json:"transaction_hash"`
+	ClassHash       *felt.Felt `json:"class_hash"`
+}
+
+// AddDeployAccountTransactionResponse is the response of AddDeployAccountTransaction.
+type AddDeployAccountTransactionResponse struct {
+	TransactionHash *felt.Felt `json:"transaction_hash"`
+	ContractAddress *felt.Felt `json:"contract_address"`
+}
+
+// AddInvokeTransaction submits a typed, version-specific invoke transaction
+// via starknet_addInvokeTransaction. Accepting BroadcastInvokeTxnType instead
+// of a bare BroadcastTxn limits invokeTxn to the typed invoke wrappers, each
+// with its own JSON shape (max_fee vs resource_bounds/tip). The Version field
+// is sent as the caller set it; it is not checked against that shape.
+//
+// Parameters:
+// - ctx: the context
+// - invokeTxn: the version-specific invoke transaction to broadcast
+// Returns:
+// - *AddInvokeTransactionResponse: the transaction hash assigned by the node
+// - error: an error, if any
+func (provider *Provider) AddInvokeTransaction(ctx context.Context, invokeTxn BroadcastInvokeTxnType) (*AddInvokeTransactionResponse, error) {
+	var result AddInvokeTransactionResponse
+	if err := do(ctx, provider.c, "starknet_addInvokeTransaction", &result, invokeTxn); err != nil {
+		return nil, tryUnwrapToRPCErr(err, ErrInsufficientAccountBalance, ErrInsufficientMaxFee, ErrInvalidTransactionNonce, ErrValidationFailure, ErrNonAccount, ErrDuplicateTx)
+	}
+	return &result, nil
+}
+
+// AddDeclareTransaction submits a typed, version-specific declare
+// transaction via starknet_addDeclareTransaction.
+//
+// Parameters:
+// - ctx: the context
+// - declareTxn: the version-specific declare transaction to broadcast
+// Returns:
+// - *AddDeclareTransactionResponse: the transaction hash and declared class hash
+// - error: an error, if any
+func (provider *Provider) AddDeclareTransaction(ctx context.Context, declareTxn BroadcastDeclareTxnType) (*AddDeclareTransactionResponse, error) {
+	var result AddDeclareTransactionResponse
+	if err := do(ctx, provider.c, "starknet_addDeclareTransaction", &result, declareTxn); err != nil {
+		return nil, tryUnwrapToRPCErr(err, ErrClassAlreadyDeclared, ErrCompilationFailed, ErrInvalidContractClass, ErrContractClassSizeTooLarge, ErrCompiledClassHashMismatch)
+	}
+	return &result, nil
+}
+
+// AddDeployAccountTransaction submits a typed, version-specific deploy
+// account transaction via starknet_addDeployAccountTransaction.
+//
+// Parameters:
+// - ctx: the context
+// - deployAccountTxn: the version-specific deploy account transaction to broadcast
+// Returns:
+// - *AddDeployAccountTransactionResponse: the transaction hash and the deployed contract address
+// - error: an error, if any
+func (provider *Provider) AddDeployAccountTransaction(ctx context.Context, deployAccountTxn BroadcastDeployAccountTxnType) (*AddDeployAccountTransactionResponse, error) {
+	var result AddDeployAccountTransactionResponse
+	if err := do(ctx, provider.c, "starknet_addDeployAccountTransaction", &result, deployAccountTxn); err != nil {
+		return nil, tryUnwrapToRPCErr(err, ErrInsufficientAccountBalance, ErrInsufficientMaxFee, ErrInvalidTransactionNonce, ErrClassHashNotFound)
+	}
+	return &result, nil
+}
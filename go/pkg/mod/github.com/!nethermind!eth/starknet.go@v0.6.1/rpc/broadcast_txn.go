@@ -0,0 +1,180 @@
+package rpc
+
+import "github.com/NethermindEth/juno/core/felt"
+
+// BroadcastInvokeTxnType is implemented by every invoke transaction version
+// that can be submitted through starknet_addInvokeTransaction or included in
+// a starknet_estimateFee batch.
+type BroadcastInvokeTxnType interface {
+	BroadcastTxn
+	InvokeVersion() TransactionVersion
+}
+
+// BroadcastDeclareTxnType is implemented by every declare transaction
+// version that can be submitted through starknet_addDeclareTransaction.
+type BroadcastDeclareTxnType interface {
+	BroadcastTxn
+	DeclareVersion() TransactionVersion
+}
+
+// BroadcastDeployAccountTxnType is implemented by every deploy account
+// transaction version that can be submitted through
+// starknet_addDeployAccountTransaction.
+type BroadcastDeployAccountTxnType interface {
+	BroadcastTxn
+	DeployAccountVersion() TransactionVersion
+}
+
+// BroadcastTxnType is the closed union of BroadcastInvokeTxnType,
+// BroadcastDeclareTxnType, and BroadcastDeployAccountTxnType: exactly the
+// version-discriminated wrappers, and nothing else satisfying the looser
+// BroadcastTxn. Provider.EstimateFee accepts a batch of these instead of a
+// bare []BroadcastTxn, so every entry has one wrapper's JSON shape. The
+// wrapper's Version field is sent as the caller set it; it is not checked
+// against the wrapper's own version.
+type BroadcastTxnType interface {
+	BroadcastTxn
+	isBroadcastTxnType()
+}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn InvokeTxnV0) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn InvokeTxnV1) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn BroadcastInvokeTxnV3) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn DeployAccountTxn) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn BroadcastDeployAccountTxnV3) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn DeclareTxnV1) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn DeclareTxnV2) isBroadcastTxnType() {}
+
+// isBroadcastTxnType implements BroadcastTxnType.
+func (txn DeclareTxnV3) isBroadcastTxnType() {}
+
+// InvokeVersion returns the transaction version of the txn type, so callers
+// can branch on it without re-parsing the version field. The value is fixed
+// per type; the Version field itself is not read.
+func (txn InvokeTxnV0) InvokeVersion() TransactionVersion { return TransactionV0 }
+
+// InvokeVersion returns the transaction version carried by the txn.
+func (txn InvokeTxnV1) InvokeVersion() TransactionVersion { return TransactionV1 }
+
+// InvokeVersion returns the transaction version carried by the txn.
+func (txn BroadcastInvokeTxnV3) InvokeVersion() TransactionVersion { return TransactionV3 }
+
+// DeployAccountVersion returns the transaction version carried by the txn.
+func (txn DeployAccountTxn) DeployAccountVersion() TransactionVersion { return TransactionV1 }
+
+// DeployAccountVersion returns the transaction version carried by the txn.
+func (txn BroadcastDeployAccountTxnV3) DeployAccountVersion() TransactionVersion { return TransactionV3 }
+
+// DeclareVersion returns the transaction version carried by the txn.
+func (txn DeclareTxnV1) DeclareVersion() TransactionVersion { return TransactionV1 }
+
+// DeclareVersion returns the transaction version carried by the txn.
+func (txn DeclareTxnV2) DeclareVersion() TransactionVersion { return TransactionV2 }
+
+// DeclareVersion returns the transaction version carried by the txn.
+func (txn DeclareTxnV3) DeclareVersion() TransactionVersion { return TransactionV3 }
+
+// ResourceBounds carries the v3 fee-market resource limits for one resource
+// kind (L1 gas or L2 gas), as required by starknet_estimateFee and the
+// broadcast v3 txns.
+type ResourceBounds struct {
+	MaxAmount       string `json:"max_amount"`
+	MaxPricePerUnit string `json:"max_price_per_unit"`
+}
+
+// ResourceBoundsMapping is the `l1_gas`/`l2_gas` resource_bounds object
+// carried by every v3 transaction.
+type ResourceBoundsMapping struct {
+	L1Gas ResourceBounds `json:"l1_gas"`
+	L2Gas ResourceBounds `json:"l2_gas"`
+}
+
+// BroadcastInvokeTxnV3 is a v3 invoke transaction, using resource_bounds and
+// tip instead of the legacy max_fee field.
+type BroadcastInvokeTxnV3 struct {
+	Type                  TransactionType       `json:"type"`
+	Version               TransactionVersion    `json:"version"`
+	SenderAddress         *felt.Felt            `json:"sender_address"`
+	Calldata              []*felt.Felt          `json:"calldata"`
+	Signature             []*felt.Felt          `json:"signature"`
+	Nonce                 *felt.Felt            `json:"nonce"`
+	ResourceBounds        ResourceBoundsMapping `json:"resource_bounds"`
+	Tip                   string                `json:"tip"`
+	PaymasterData         []*felt.Felt          `json:"paymaster_data"`
+	AccountDeploymentData []*felt.Felt          `json:"account_deployment_data"`
+	NonceDataAvailabilityMode string            `json:"nonce_data_availability_mode"`
+	FeeDataAvailabilityMode   string            `json:"fee_data_availability_mode"`
+}
+
+// BroadcastDeployAccountTxnV3 is a v3 deploy account transaction, using
+// resource_bounds and tip instead of the legacy max_fee field.
+type BroadcastDeployAccountTxnV3 struct {
+	Type                TransactionType       `json:"type"`
+	Version             TransactionVersion    `json:"version"`
+	Signature           []*felt.Felt          `json:"signature"`
+	Nonce               *felt.Felt            `json:"nonce"`
+	ContractAddressSalt *felt.Felt            `json:"contract_address_salt"`
+	ConstructorCalldata []*felt.Felt          `json:"constructor_calldata"`
+	ClassHash           *felt.Felt            `json:"class_hash"`
+	ResourceBounds      ResourceBoundsMapping `json:"resource_bounds"`
+	Tip                 string                `json:"tip"`
+	PaymasterData       []*felt.Felt          `json:"paymaster_data"`
+	NonceDataAvailabilityMode string          `json:"nonce_data_availability_mode"`
+	FeeDataAvailabilityMode   string          `json:"fee_data_availability_mode"`
+}
+
+// DeclareTxnV1 is a v1 declare transaction, declaring a legacy (Cairo 0)
+// contract class by its class hash and a legacy max_fee.
+type DeclareTxnV1 struct {
+	Type          TransactionType    `json:"type"`
+	Version       TransactionVersion `json:"version"`
+	SenderAddress *felt.Felt         `json:"sender_address"`
+	MaxFee        *felt.Felt         `json:"max_fee"`
+	Signature     []*felt.Felt       `json:"signature"`
+	Nonce         *felt.Felt         `json:"nonce"`
+	ClassHash     *felt.Felt         `json:"class_hash"`
+}
+
+// DeclareTxnV2 is a v2 declare transaction, adding compiled_class_hash to
+// declare a Sierra (Cairo 1) contract class.
+type DeclareTxnV2 struct {
+	Type              TransactionType    `json:"type"`
+	Version           TransactionVersion `json:"version"`
+	SenderAddress     *felt.Felt         `json:"sender_address"`
+	CompiledClassHash *felt.Felt         `json:"compiled_class_hash"`
+	MaxFee            *felt.Felt         `json:"max_fee"`
+	Signature         []*felt.Felt       `json:"signature"`
+	Nonce             *felt.Felt         `json:"nonce"`
+	ClassHash         *felt.Felt         `json:"class_hash"`
+}
+
+// DeclareTxnV3 is a v3 declare transaction, using resource_bounds and tip
+// instead of the legacy max_fee field.
+type DeclareTxnV3 struct {
+	Type                      TransactionType       `json:"type"`
+	Version                   TransactionVersion    `json:"version"`
+	SenderAddress             *felt.Felt            `json:"sender_address"`
+	CompiledClassHash         *felt.Felt            `json:"compiled_class_hash"`
+	Signature                 []*felt.Felt          `json:"signature"`
+	Nonce                     *felt.Felt            `json:"nonce"`
+	ClassHash                 *felt.Felt            `json:"class_hash"`
+	ResourceBounds            ResourceBoundsMapping `json:"resource_bounds"`
+	Tip                       string                `json:"tip"`
+	PaymasterData             []*felt.Felt          `json:"paymaster_data"`
+	AccountDeploymentData     []*felt.Felt          `json:"account_deployment_data"`
+	NonceDataAvailabilityMode string                `json:"nonce_data_availability_mode"`
+	FeeDataAvailabilityMode   string                `json:"fee_data_availability_mode"`
+}
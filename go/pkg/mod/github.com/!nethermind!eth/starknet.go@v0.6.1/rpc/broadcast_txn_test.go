@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/NethermindEth/starknet.go/utils"
+	"github.com/test-go/testify/require"
+)
+
+// TestBroadcastInvokeTxnVersionDispatch checks that every invoke txn type
+// reports, through InvokeVersion, the version its Version field is built
+// with.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBroadcastInvokeTxnVersionDispatch(t *testing.T) {
+	testSet := []struct {
+		Txn             BroadcastInvokeTxnType
+		ExpectedVersion TransactionVersion
+	}{
+		{
+			Txn:             InvokeTxnV0{Type: TransactionType_Invoke, Version: TransactionV0},
+			ExpectedVersion: TransactionV0,
+		},
+		{
+			Txn:             InvokeTxnV1{Type: TransactionType_Invoke, Version: TransactionV1},
+			ExpectedVersion: TransactionV1,
+		},
+		{
+			Txn:             BroadcastInvokeTxnV3{Type: TransactionType_Invoke, Version: TransactionV3},
+			ExpectedVersion: TransactionV3,
+		},
+	}
+
+	for _, test := range testSet {
+		require.Equal(t, test.ExpectedVersion, test.Txn.InvokeVersion())
+	}
+}
+
+// TestBroadcastDeployAccountTxnVersionDispatch checks that every deploy
+// account txn version reports the version it was built with.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBroadcastDeployAccountTxnVersionDispatch(t *testing.T) {
+	testSet := []struct {
+		Txn             BroadcastDeployAccountTxnType
+		ExpectedVersion TransactionVersion
+	}{
+		{
+			Txn: DeployAccountTxn{
+				Type:                TransactionType_DeployAccount,
+				Version:             TransactionV1,
+				ClassHash:           utils.TestHexToFelt(t, "0xdeadbeef"),
+				ContractAddressSalt: utils.TestHexToFelt(t, "0x1"),
+			},
+			ExpectedVersion: TransactionV1,
+		},
+		{
+			Txn: BroadcastDeployAccountTxnV3{
+				Type:                TransactionType_DeployAccount,
+				Version:             TransactionV3,
+				ClassHash:           utils.TestHexToFelt(t, "0xdeadbeef"),
+				ContractAddressSalt: utils.TestHexToFelt(t, "0x1"),
+			},
+			ExpectedVersion: TransactionV3,
+		},
+	}
+
+	for _, test := range testSet {
+		require.Equal(t, test.ExpectedVersion, test.Txn.DeployAccountVersion())
+	}
+}
+
+// TestBroadcastDeclareTxnVersionDispatch checks that every declare txn type
+// reports, through DeclareVersion, the version its Version field is built
+// with.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBroadcastDeclareTxnVersionDispatch(t *testing.T) {
+	testSet := []struct {
+		Txn             BroadcastDeclareTxnType
+		ExpectedVersion TransactionVersion
+	}{
+		{
+			Txn: DeclareTxnV1{
+				Type:          TransactionType_Declare,
+				Version:       TransactionV1,
+				SenderAddress: utils.TestHexToFelt(t, "0x1"),
+				ClassHash:     utils.TestHexToFelt(t, "0xdeadbeef"),
+			},
+			ExpectedVersion: TransactionV1,
+		},
+		{
+			Txn: DeclareTxnV2{
+				Type:              TransactionType_Declare,
+				Version:           TransactionV2,
+				SenderAddress:     utils.TestHexToFelt(t, "0x1"),
+				ClassHash:         utils.TestHexToFelt(t, "0xdeadbeef"),
+				CompiledClassHash: utils.TestHexToFelt(t, "0xc1a55"),
+			},
+			ExpectedVersion: TransactionV2,
+		},
+		{
+			Txn: DeclareTxnV3{
+				Type:              TransactionType_Declare,
+				Version:           TransactionV3,
+				SenderAddress:     utils.TestHexToFelt(t, "0x1"),
+				ClassHash:         utils.TestHexToFelt(t, "0xdeadbeef"),
+				CompiledClassHash: utils.TestHexToFelt(t, "0xc1a55"),
+			},
+			ExpectedVersion: TransactionV3,
+		},
+	}
+
+	for _, test := range testSet {
+		require.Equal(t, test.ExpectedVersion, test.Txn.DeclareVersion())
+	}
+}
@@ -0,0 +1,256 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/curve"
+	"golang.org/x/crypto/sha3"
+)
+
+// SierraEntryPoint is a single entry point of a Sierra (Cairo 1) contract
+// class, pointing at the function's index inside the compiled
+// sierra_program by its selector.
+type SierraEntryPoint struct {
+	// FunctionIdx is the index of the function in the program.
+	FunctionIdx int `json:"function_idx"`
+	// Selector is the selector of the function.
+	Selector *felt.Felt `json:"selector"`
+}
+
+// EntryPointsByType groups a Sierra contract class's entry points by the
+// calling convention that can invoke them.
+type EntryPointsByType struct {
+	Constructor SierraEntryPoint   `json:"CONSTRUCTOR"`
+	External    []SierraEntryPoint `json:"EXTERNAL"`
+	L1Handler   []SierraEntryPoint `json:"L1_HANDLER"`
+}
+
+// ContractClass represents a Sierra (Cairo 1) contract class as returned by
+// starknet_getClass/starknet_getClassAt.
+type ContractClass struct {
+	// SierraProgram is the list of Sierra instructions that make up the program.
+	SierraProgram []*felt.Felt `json:"sierra_program"`
+	// ContractClassVersion is the compiler version used to compile the class, e.g. "0.1.0".
+	ContractClassVersion string `json:"contract_class_version"`
+	// EntryPointsByType groups the class's entry points by CONSTRUCTOR/EXTERNAL/L1_HANDLER.
+	EntryPointsByType EntryPointsByType `json:"entry_points_by_type"`
+	// ABI is the raw JSON-encoded ABI string, as the node returns it.
+	ABI string `json:"abi"`
+}
+
+// ParsedABI unmarshals the class's raw ABI string into its structured,
+// discriminated-union representation.
+//
+// Parameters:
+// - none
+// Returns:
+// - []ABIEntry: the decoded ABI entries (functions, structs, enums, events, interfaces, impls)
+// - error: an error, if the ABI string is not valid JSON or contains an unknown entry type
+func (c ContractClass) ParsedABI() ([]ABIEntry, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(c.ABI), &raw); err != nil {
+		return nil, err
+	}
+
+	entries := make([]ABIEntry, 0, len(raw))
+	for _, r := range raw {
+		entry, err := unmarshalABIEntry(r)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// EntryPointBySelector returns the Sierra entry point matching the given
+// selector, searching the constructor, external, and L1 handler groups in
+// that order.
+//
+// Parameters:
+// - sel: the entry point selector to look up
+// Returns:
+// - *SierraEntryPoint: the matching entry point, or nil if none is found
+func (c ContractClass) EntryPointBySelector(sel *felt.Felt) *SierraEntryPoint {
+	if c.EntryPointsByType.Constructor.Selector != nil && c.EntryPointsByType.Constructor.Selector.Equal(sel) {
+		return &c.EntryPointsByType.Constructor
+	}
+	for _, group := range [][]SierraEntryPoint{c.EntryPointsByType.External, c.EntryPointsByType.L1Handler} {
+		for i := range group {
+			if group[i].Selector.Equal(sel) {
+				return &group[i]
+			}
+		}
+	}
+	return nil
+}
+
+// EventKeys returns the selector of every event declared in the class's ABI.
+//
+// Parameters:
+// - none
+// Returns:
+// - []*felt.Felt: the event key selectors, in ABI order
+// - error: an error, if the ABI cannot be parsed
+func (c ContractClass) EventKeys() ([]*felt.Felt, error) {
+	entries, err := c.ParsedABI()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*felt.Felt, 0)
+	for _, entry := range entries {
+		if event, ok := entry.(*ABIEvent); ok {
+			keys = append(keys, starknetKeccak([]byte(event.Name)))
+		}
+	}
+	return keys, nil
+}
+
+// classHashPrefix is the Sierra class hash domain-separator, the ASCII
+// string "CONTRACT_CLASS_V0.1.0".
+var classHashPrefix, _ = new(felt.Felt).SetString("0x434f4e54524143545f434c4153535f56302e312e30")
+
+// Hash computes the class hash of this Sierra contract class per the
+// Starknet spec:
+//
+//	poseidon_hash(prefix, external_entry_points_hash, l1_handler_entry_points_hash, constructor_entry_points_hash, abi_hash, sierra_program_hash)
+//
+// Parameters:
+// - none
+// Returns:
+// - *felt.Felt: the computed class hash
+func (c ContractClass) Hash() *felt.Felt {
+	return curve.Poseidon(
+		classHashPrefix,
+		entryPointsHash(c.EntryPointsByType.External),
+		entryPointsHash(c.EntryPointsByType.L1Handler),
+		entryPointsHash([]SierraEntryPoint{c.EntryPointsByType.Constructor}),
+		starknetKeccak([]byte(c.ABI)),
+		curve.Poseidon(c.SierraProgram...),
+	)
+}
+
+// entryPointsHash flattens (selector, function_idx) pairs, in that order per
+// the Starknet class hash spec, and Poseidon-hashes them.
+func entryPointsHash(entryPoints []SierraEntryPoint) *felt.Felt {
+	flattened := make([]*felt.Felt, 0, len(entryPoints)*2)
+	for _, ep := range entryPoints {
+		flattened = append(flattened, ep.Selector, new(felt.Felt).SetUint64(uint64(ep.FunctionIdx)))
+	}
+	return curve.Poseidon(flattened...)
+}
+
+// starknetKeccak250Mask is 2**250, the modulus the Starknet spec applies to
+// a raw Keccak-256 digest (keeping its low 250 bits) to fit it into a felt.
+var starknetKeccak250Mask = new(big.Int).Lsh(big.NewInt(1), 250)
+
+// starknetKeccak computes the Starknet variant of Keccak-256 used for ABI
+// hashes and name-derived selectors: the raw Keccak-256 digest of data,
+// reduced to its low 250 bits.
+func starknetKeccak(data []byte) *felt.Felt {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+
+	digest := new(big.Int).SetBytes(hash.Sum(nil))
+	digest.Mod(digest, starknetKeccak250Mask)
+	return new(felt.Felt).SetBytes(digest.Bytes())
+}
+
+// ABIEntry is implemented by every kind of entry that can appear in a
+// Sierra contract class's ABI: functions, structs, enums, events,
+// interfaces, and impls.
+type ABIEntry interface {
+	abiEntryType() string
+}
+
+// ABIFunction is a "function" entry in a Sierra class's ABI.
+type ABIFunction struct {
+	Name    string     `json:"name"`
+	Inputs  []ABIParam `json:"inputs"`
+	Outputs []ABIParam `json:"outputs"`
+}
+
+func (*ABIFunction) abiEntryType() string { return "function" }
+
+// ABIStruct is a "struct" entry in a Sierra class's ABI.
+type ABIStruct struct {
+	Name    string     `json:"name"`
+	Members []ABIParam `json:"members"`
+}
+
+func (*ABIStruct) abiEntryType() string { return "struct" }
+
+// ABIEnum is an "enum" entry in a Sierra class's ABI.
+type ABIEnum struct {
+	Name     string     `json:"name"`
+	Variants []ABIParam `json:"variants"`
+}
+
+func (*ABIEnum) abiEntryType() string { return "enum" }
+
+// ABIEvent is an "event" entry in a Sierra class's ABI.
+type ABIEvent struct {
+	Name string     `json:"name"`
+	Kind string     `json:"kind"`
+	Members []ABIParam `json:"members,omitempty"`
+}
+
+func (*ABIEvent) abiEntryType() string { return "event" }
+
+// ABIInterface is an "interface" entry in a Sierra class's ABI.
+type ABIInterface struct {
+	Name  string     `json:"name"`
+	Items []ABIEntry `json:"items"`
+}
+
+func (*ABIInterface) abiEntryType() string { return "interface" }
+
+// ABIImpl is an "impl" entry in a Sierra class's ABI.
+type ABIImpl struct {
+	Name           string `json:"name"`
+	InterfaceName string `json:"interface_name"`
+}
+
+func (*ABIImpl) abiEntryType() string { return "impl" }
+
+// ABIParam is a named, typed member of a function, struct, enum, or event ABI entry.
+type ABIParam struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func unmarshalABIEntry(raw json.RawMessage) (ABIEntry, error) {
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return nil, err
+	}
+
+	var entry ABIEntry
+	switch discriminator.Type {
+	case "function", "l1_handler", "constructor":
+		entry = &ABIFunction{}
+	case "struct":
+		entry = &ABIStruct{}
+	case "enum":
+		entry = &ABIEnum{}
+	case "event":
+		entry = &ABIEvent{}
+	case "interface":
+		entry = &ABIInterface{}
+	case "impl":
+		entry = &ABIImpl{}
+	default:
+		return nil, fmt.Errorf("unknown ABI entry type %q", discriminator.Type)
+	}
+
+	if err := json.Unmarshal(raw, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
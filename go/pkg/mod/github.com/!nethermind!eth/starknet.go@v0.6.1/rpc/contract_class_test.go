@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/utils"
+	"github.com/test-go/testify/require"
+)
+
+// TestContractClassParsedABI tests that ParsedABI decodes every ABI entry
+// kind into its discriminated-union Go type.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestContractClassParsedABI(t *testing.T) {
+	class := ContractClass{
+		ABI: `[
+			{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "felt"}], "outputs": []},
+			{"type": "struct", "name": "Pair", "members": [{"name": "a", "type": "felt"}]},
+			{"type": "enum", "name": "Status", "variants": [{"name": "Ok", "type": "()"}]},
+			{"type": "event", "name": "Transfer", "kind": "struct"},
+			{"type": "interface", "name": "IERC20", "items": []},
+			{"type": "impl", "name": "ERC20Impl", "interface_name": "IERC20"}
+		]`,
+	}
+
+	entries, err := class.ParsedABI()
+	require.Nil(t, err)
+	require.Len(t, entries, 6)
+
+	fn, ok := entries[0].(*ABIFunction)
+	require.True(t, ok)
+	require.Equal(t, "transfer", fn.Name)
+
+	_, ok = entries[1].(*ABIStruct)
+	require.True(t, ok)
+	_, ok = entries[2].(*ABIEnum)
+	require.True(t, ok)
+
+	event, ok := entries[3].(*ABIEvent)
+	require.True(t, ok)
+	require.Equal(t, "Transfer", event.Name)
+
+	_, ok = entries[4].(*ABIInterface)
+	require.True(t, ok)
+	_, ok = entries[5].(*ABIImpl)
+	require.True(t, ok)
+}
+
+// TestContractClassEventKeys tests that EventKeys returns one selector per
+// declared event, in ABI order.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestContractClassEventKeys(t *testing.T) {
+	class := ContractClass{
+		ABI: `[{"type": "event", "name": "Transfer", "kind": "struct"}, {"type": "function", "name": "noop", "inputs": [], "outputs": []}]`,
+	}
+
+	keys, err := class.EventKeys()
+	require.Nil(t, err)
+	require.Len(t, keys, 1)
+}
+
+// TestContractClassEntryPointBySelector tests looking up entry points by
+// selector across the constructor, external, and L1 handler groups.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestContractClassEntryPointBySelector(t *testing.T) {
+	externalSelector := utils.TestHexToFelt(t, "0x1")
+	constructorSelector := utils.TestHexToFelt(t, "0x2")
+
+	class := ContractClass{
+		EntryPointsByType: EntryPointsByType{
+			Constructor: SierraEntryPoint{FunctionIdx: 0, Selector: constructorSelector},
+			External:    []SierraEntryPoint{{FunctionIdx: 1, Selector: externalSelector}},
+		},
+	}
+
+	require.Equal(t, externalSelector, class.EntryPointBySelector(externalSelector).Selector)
+	require.Equal(t, constructorSelector, class.EntryPointBySelector(constructorSelector).Selector)
+	require.Nil(t, class.EntryPointBySelector(utils.TestHexToFelt(t, "0xdead")))
+}
+
+// TestContractClassHash tests that Hash is deterministic for a fixed
+// sierra_program/ABI/entry point set, and that it actually depends on every
+// field the spec says it does: mutating sierra_program, the ABI, or any
+// entry point group must change the hash, or a bug that dropped one of
+// those inputs (e.g. forgetting to hash the ABI) would pass this test
+// unnoticed. curve.Poseidon isn't vendored in this fragment, so a genuine
+// end-to-end vector can't be computed offline; this pins the function's
+// input sensitivity instead of a fabricated numeric output.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestContractClassHash(t *testing.T) {
+	base := ContractClass{
+		SierraProgram:        []*felt.Felt{utils.TestHexToFelt(t, "0x1"), utils.TestHexToFelt(t, "0x2")},
+		ContractClassVersion: "0.1.0",
+		ABI:                  `[]`,
+		EntryPointsByType: EntryPointsByType{
+			Constructor: SierraEntryPoint{FunctionIdx: 0, Selector: utils.TestHexToFelt(t, "0x10")},
+		},
+	}
+
+	h1 := base.Hash()
+	h2 := base.Hash()
+	require.NotNil(t, h1)
+	require.Equal(t, h1, h2)
+
+	withDifferentProgram := base
+	withDifferentProgram.SierraProgram = []*felt.Felt{utils.TestHexToFelt(t, "0x1"), utils.TestHexToFelt(t, "0x3")}
+	require.NotEqual(t, h1, withDifferentProgram.Hash())
+
+	withDifferentABI := base
+	withDifferentABI.ABI = `[{"type":"function"}]`
+	require.NotEqual(t, h1, withDifferentABI.Hash())
+
+	withDifferentConstructor := base
+	withDifferentConstructor.EntryPointsByType.Constructor = SierraEntryPoint{FunctionIdx: 1, Selector: utils.TestHexToFelt(t, "0x11")}
+	require.NotEqual(t, h1, withDifferentConstructor.Hash())
+}
@@ -79,7 +79,17 @@ func TestClassAt(t *testing.T) {
 				t.Fatal("code should exist")
 			}
 		case ContractClass:
-			panic("Not covered")
+			diff, err := spy.Compare(class, false)
+			if err != nil {
+				t.Fatal("expecting to match", err)
+			}
+			if diff != "FullMatch" {
+				spy.Compare(class, true)
+				t.Fatal("structure expecting to be FullMatch, instead", diff)
+			}
+			if _, err := class.ParsedABI(); err != nil {
+				t.Fatal("ABI should parse", err)
+			}
 		}
 
 	}
@@ -171,6 +181,9 @@ func TestClassHashAt(t *testing.T) {
 //   - If the response is of type ContractClass:
 //   - Compares the constructor entry point with the expected entry point constructor.
 //   - If they are not equal, it reports an error.
+//   - Looks the constructor entry point back up by selector and checks it round-trips.
+//   - Parses the ABI, checking it does not error.
+//   - Computes the class hash and checks it equals the requested ClassHash.
 //
 // The function is used for testing the behavior of the Class function in different scenarios.
 //
@@ -236,6 +249,17 @@ func TestClass(t *testing.T) {
 			}
 		case ContractClass:
 			require.Equal(t, class.EntryPointsByType.Constructor, test.ExpectedEntryPointConstructor)
+
+			entryPoint := class.EntryPointBySelector(test.ExpectedEntryPointConstructor.Selector)
+			if entryPoint == nil {
+				t.Fatal("constructor entry point should be found by selector")
+			}
+			require.Equal(t, test.ExpectedEntryPointConstructor, *entryPoint)
+
+			if _, err := class.ParsedABI(); err != nil {
+				t.Fatal("ABI should parse", err)
+			}
+			require.Equal(t, test.ClassHash, class.Hash())
 		}
 	}
 }
@@ -412,12 +436,59 @@ func TestEstimateMessageFee(t *testing.T) {
 	}
 }
 
+// TestPrecomputeDeployAccountAddress tests that ClassHashAt, queried at the
+// address PrecomputeDeployAccountAddress computes, reports the deploy txn's
+// class hash. No deployment is submitted first: the test checks whatever
+// the testEnv provider reports for that address.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestPrecomputeDeployAccountAddress(t *testing.T) {
+	testConfig := beforeEach(t)
+
+	type testSetType struct {
+		Txn               DeployAccountTxn
+		ExpectedClassHash *felt.Felt
+	}
+	testSet := map[string][]testSetType{
+		"mock": {
+			{
+				Txn: DeployAccountTxn{
+					Type:                TransactionType_DeployAccount,
+					Version:             TransactionV1,
+					ContractAddressSalt: utils.TestHexToFelt(t, "0x74ddc51af144d1bd805eb4184d07453d7c4388660270a7851fec387e654a50e"),
+					ClassHash:           utils.TestHexToFelt(t, "0xdeadbeef"),
+					ConstructorCalldata: utils.TestHexArrToFelt(t, []string{"0x33434ad846cdd5f23eb73ff09fe6fddd568284a0fb7d1be20ee482f044dabe2"}),
+				},
+				ExpectedClassHash: utils.TestHexToFelt(t, "0xdeadbeef"),
+			},
+		},
+		"testnet": {},
+		"mainnet": {},
+	}[testEnv]
+
+	for _, test := range testSet {
+		precomputed := testConfig.provider.PrecomputeDeployAccountAddress(test.Txn)
+
+		spy := NewSpy(testConfig.provider.c)
+		testConfig.provider.c = spy
+		classHash, err := testConfig.provider.ClassHashAt(context.Background(), WithBlockTag("latest"), precomputed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, test.ExpectedClassHash, classHash)
+	}
+}
+
 func TestEstimateFee(t *testing.T) {
 	testConfig := beforeEach(t)
 
 	testBlockNumber := uint64(15643)
 	type testSetType struct {
-		txs           []BroadcastTxn
+		txs           []BroadcastTxnType
 		simFlags      []SimulationFlag
 		blockID       BlockID
 		expectedResp  []FeeEstimate
@@ -426,7 +497,7 @@ func TestEstimateFee(t *testing.T) {
 	testSet := map[string][]testSetType{
 		"mainnet": {
 			{
-				txs: []BroadcastTxn{
+				txs: []BroadcastTxnType{
 					InvokeTxnV0{
 						Type:    TransactionType_Invoke,
 						Version: TransactionV0,
@@ -466,7 +537,7 @@ func TestEstimateFee(t *testing.T) {
 			},
 			{
 
-				txs: []BroadcastTxn{
+				txs: []BroadcastTxnType{
 					DeployAccountTxn{
 
 						Type:    TransactionType_DeployAccount,
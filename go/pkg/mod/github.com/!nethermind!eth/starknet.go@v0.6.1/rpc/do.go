@@ -0,0 +1,20 @@
+package rpc
+
+import "context"
+
+// do calls the given JSON-RPC method with params, encoding each param
+// through its own MarshalJSON (if any) so version-specific broadcast txn
+// wrappers (v0/v1/v3) serialize with the fields the node expects for that
+// version rather than a single shared shape.
+//
+// Parameters:
+// - ctx: the context
+// - c: the underlying JSON-RPC callable
+// - method: the JSON-RPC method name
+// - result: a pointer to decode the response into
+// - params: the method's positional parameters
+// Returns:
+// - error: an error, if any
+func do(ctx context.Context, c callCloser, method string, result any, params ...any) error {
+	return c.CallContext(ctx, result, method, params...)
+}
@@ -0,0 +1,26 @@
+package rpc
+
+import "context"
+
+// EstimateFee estimates the fee for a batch of transactions via
+// starknet_estimateFee. Every entry must be one of the typed broadcast txn
+// wrappers (BroadcastInvokeTxnType, BroadcastDeclareTxnType, or
+// BroadcastDeployAccountTxnType) rather than a bare BroadcastTxn, so each
+// entry is sent with its wrapper's JSON shape. The version field is sent as
+// the caller set it; it is not checked against that shape.
+//
+// Parameters:
+// - ctx: the context
+// - requests: the transactions to estimate, each a typed broadcast txn wrapper
+// - simulationFlags: flags controlling simulation behaviour
+// - blockID: the block to estimate against
+// Returns:
+// - []FeeEstimate: one estimate per request, in order
+// - error: an error, if any
+func (provider *Provider) EstimateFee(ctx context.Context, requests []BroadcastTxnType, simulationFlags []SimulationFlag, blockID BlockID) ([]FeeEstimate, error) {
+	var result []FeeEstimate
+	if err := do(ctx, provider.c, "starknet_estimateFee", &result, requests, simulationFlags, blockID); err != nil {
+		return nil, tryUnwrapToRPCErr(err, ErrContractNotFound, ErrContractError, ErrBlockNotFound)
+	}
+	return result, nil
+}
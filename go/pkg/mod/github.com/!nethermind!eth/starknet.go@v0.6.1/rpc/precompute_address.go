@@ -0,0 +1,21 @@
+package rpc
+
+import (
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/contracts"
+)
+
+// PrecomputeDeployAccountAddress computes the contract address that a
+// DeployAccountTxn will be assigned before it is submitted to the network.
+//
+// This lets callers fund or inspect a counterfactual account (e.g. via
+// ClassHashAt or StorageAt at the precomputed address) ahead of broadcasting
+// the actual DeployAccount transaction.
+//
+// Parameters:
+// - txn: the DeployAccountTxn that will be broadcast
+// Returns:
+// - *felt.Felt: the precomputed contract address
+func (provider *Provider) PrecomputeDeployAccountAddress(txn DeployAccountTxn) *felt.Felt {
+	return contracts.PrecomputeAddress(&felt.Zero, txn.ContractAddressSalt, txn.ClassHash, txn.ConstructorCalldata)
+}
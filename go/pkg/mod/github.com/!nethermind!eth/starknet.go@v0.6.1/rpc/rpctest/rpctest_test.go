@@ -0,0 +1,71 @@
+package rpctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/test-go/testify/require"
+)
+
+// TestHandlerServesFixture tests that a request whose fixture exists on
+// disk is answered from the fixture, without a recorder configured.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestHandlerServesFixture(t *testing.T) {
+	handler := NewHandler(t, "mock")
+
+	req := jsonrpcRequest{ID: json.RawMessage(`1`), Method: "starknet_getClassHashAt", Params: json.RawMessage(`["latest","0xdeadbeef"]`)}
+	path := handler.fixturePath(req)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(`"0xdeadbeef"`), 0o644))
+	t.Cleanup(func() { os.RemoveAll(filepath.Join("testdata", "mock")) })
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	body, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "starknet_getClassHashAt",
+		"params":  []string{"latest", "0xdeadbeef"},
+	})
+	resp, err := http.Post(server.URL, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var envelope struct {
+		Result string `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(respBody, &envelope))
+	require.Equal(t, "0xdeadbeef", envelope.Result)
+}
+
+// TestHandlerMissingFixtureWithoutRecorder tests that a cache miss with no
+// recorder configured fails loudly instead of silently hanging on a live dial.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestHandlerMissingFixtureWithoutRecorder(t *testing.T) {
+	handler := NewHandler(t, "mock")
+	req := jsonrpcRequest{Method: "starknet_getNonce", Params: json.RawMessage(fmt.Sprintf("%d", 1))}
+
+	_, err := handler.load(req)
+	require.Error(t, err)
+}
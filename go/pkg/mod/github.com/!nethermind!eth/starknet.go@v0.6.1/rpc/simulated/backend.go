@@ -0,0 +1,421 @@
+// Package simulated provides an in-memory Starknet backend for deterministic
+// testing, modeled on go-ethereum's backends.SimulatedBackend: it answers
+// reads (nonce, storage, class lookups, fee estimation) and writes (call,
+// declare, deploy account, invoke) against local state instead of a live
+// node. It still does not implement block/transaction lookups (there is no
+// block-indexed transaction log here), so it is not a drop-in for every
+// rpc.Provider method — only the subset listed below.
+package simulated
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/contracts"
+	"github.com/NethermindEth/starknet.go/rpc"
+)
+
+// Backend is an in-memory Starknet node. It keeps per-address nonces and
+// storage, declared classes, and a replay log of every call it served, and
+// advances its own block/clock only when told to via Commit/AdjustTime.
+type Backend struct {
+	mu sync.Mutex
+
+	blockNumber uint64
+	blockTime   time.Time
+
+	classes   map[felt.Felt]rpc.ContractClass
+	classHash map[felt.Felt]*felt.Felt // contract address -> class hash
+	nonces    map[felt.Felt]*felt.Felt
+	storage   map[felt.Felt]map[string]*felt.Felt
+
+	feeEstimate rpc.FeeEstimate
+	callResult  []*felt.Felt
+
+	txnSeq uint64
+
+	calls []CallLog
+}
+
+// CallLog records a single method invocation served by the backend, so spy
+// based tests (NewSpy/spy.Compare) can assert structural fidelity without a
+// live testnet.
+type CallLog struct {
+	Method string
+	Args   []any
+}
+
+// NewBackend creates a Backend starting at block 0 and the given wall-clock
+// time.
+//
+// Parameters:
+// - genesisTime: the timestamp assigned to the genesis block
+// Returns:
+// - *Backend: the new simulated backend
+func NewBackend(genesisTime time.Time) *Backend {
+	return &Backend{
+		blockTime: genesisTime,
+		classes:   make(map[felt.Felt]rpc.ContractClass),
+		classHash: make(map[felt.Felt]*felt.Felt),
+		nonces:    make(map[felt.Felt]*felt.Felt),
+		storage:   make(map[felt.Felt]map[string]*felt.Felt),
+		feeEstimate: rpc.FeeEstimate{
+			GasConsumed: new(felt.Felt).SetUint64(1),
+			GasPrice:    new(felt.Felt).SetUint64(1),
+			OverallFee:  new(felt.Felt).SetUint64(1),
+			FeeUnit:     rpc.UnitWei,
+		},
+	}
+}
+
+// Commit mines a new block on top of the current state, advancing
+// blockNumber by one.
+//
+// Parameters:
+// - none
+// Returns:
+// - uint64: the new block number
+func (b *Backend) Commit() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.blockNumber++
+	return b.blockNumber
+}
+
+// AdjustTime moves the backend's clock forward by d without mining a block.
+//
+// Parameters:
+// - d: the duration to advance the clock by
+// Returns:
+//
+//	none
+func (b *Backend) AdjustTime(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.blockTime = b.blockTime.Add(d)
+}
+
+// SetCallResult configures the felt slice returned by every subsequent Call,
+// regardless of the call's actual target or calldata: the backend has no
+// contract VM, so it cannot evaluate a real entry point.
+//
+// Parameters:
+// - result: the value to return from now on
+// Returns:
+//
+//	none
+func (b *Backend) SetCallResult(result []*felt.Felt) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.callResult = result
+}
+
+// nextTxnHash returns a deterministic, monotonically increasing transaction
+// hash. The backend has no real Starknet transaction-hash algorithm to
+// evaluate, so it hands out sequence numbers instead of pretending to
+// compute one.
+func (b *Backend) nextTxnHash() *felt.Felt {
+	b.txnSeq++
+	return new(felt.Felt).SetUint64(b.txnSeq)
+}
+
+// SetFeeEstimate configures the FeeEstimate (both UnitWei and UnitFri)
+// returned by every subsequent EstimateFee call, so tests can assert
+// against a known value instead of whatever arithmetic a real node performs.
+//
+// Parameters:
+// - estimate: the estimate to return from now on
+// Returns:
+//
+//	none
+func (b *Backend) SetFeeEstimate(estimate rpc.FeeEstimate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.feeEstimate = estimate
+}
+
+// DeclareClass registers a class under the given hash, as if it had been
+// declared via starknet_addDeclareTransaction.
+//
+// Parameters:
+// - classHash: the hash to register the class under
+// - class: the Sierra contract class
+// Returns:
+//
+//	none
+func (b *Backend) DeclareClass(classHash *felt.Felt, class rpc.ContractClass) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.classes[*classHash] = class
+}
+
+// DeployAccount associates a class hash with a contract address, as if that
+// address had just been deployed via starknet_addDeployAccountTransaction.
+//
+// Parameters:
+// - address: the deployed contract's address
+// - classHash: the class hash deployed at that address
+// Returns:
+//
+//	none
+func (b *Backend) DeployAccount(address, classHash *felt.Felt) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.classHash[*address] = classHash
+	if _, ok := b.nonces[*address]; !ok {
+		b.nonces[*address] = &felt.Zero
+	}
+}
+
+// CallLogs returns every call the backend has served so far, in order.
+//
+// Parameters:
+// - none
+// Returns:
+// - []CallLog: the recorded calls
+func (b *Backend) CallLogs() []CallLog {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]CallLog(nil), b.calls...)
+}
+
+func (b *Backend) record(method string, args ...any) {
+	b.calls = append(b.calls, CallLog{Method: method, Args: args})
+}
+
+// Nonce returns the current nonce of address, defaulting to zero for
+// addresses that have not been deployed.
+//
+// Parameters:
+// - ctx: the context (unused, present to satisfy rpc.Provider's signature)
+// - blockID: the block to read at (unused, the backend only tracks latest state)
+// - address: the contract address
+// Returns:
+// - *felt.Felt: the nonce
+// - error: an error, if any
+func (b *Backend) Nonce(ctx context.Context, blockID rpc.BlockID, address *felt.Felt) (*felt.Felt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_getNonce", blockID, address)
+	if nonce, ok := b.nonces[*address]; ok {
+		return nonce, nil
+	}
+	return &felt.Zero, nil
+}
+
+// StorageAt returns the value stored at key in address's storage, defaulting
+// to zero.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - address: the contract address
+// - key: the storage key
+// - blockID: the block to read at (unused, the backend only tracks latest state)
+// Returns:
+// - string: the stored value
+// - error: an error, if any
+func (b *Backend) StorageAt(ctx context.Context, address *felt.Felt, key string, blockID rpc.BlockID) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_getStorageAt", address, key, blockID)
+	if slots, ok := b.storage[*address]; ok {
+		if v, ok := slots[key]; ok {
+			return v.String(), nil
+		}
+	}
+	return (&felt.Zero).String(), nil
+}
+
+// SetStorageAt seeds address's storage at key with value, for use by tests
+// setting up fixtures before calling StorageAt.
+//
+// Parameters:
+// - address: the contract address
+// - key: the storage key
+// - value: the value to store
+// Returns:
+//
+//	none
+func (b *Backend) SetStorageAt(address *felt.Felt, key string, value *felt.Felt) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.storage[*address] == nil {
+		b.storage[*address] = make(map[string]*felt.Felt)
+	}
+	b.storage[*address][key] = value
+}
+
+// ClassHashAt returns the class hash deployed at address.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - blockID: the block to read at (unused, the backend only tracks latest state)
+// - address: the contract address
+// Returns:
+// - *felt.Felt: the class hash
+// - error: an error, if the address has not been deployed
+func (b *Backend) ClassHashAt(ctx context.Context, blockID rpc.BlockID, address *felt.Felt) (*felt.Felt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_getClassHashAt", blockID, address)
+	if hash, ok := b.classHash[*address]; ok {
+		return hash, nil
+	}
+	return nil, rpc.ErrContractNotFound
+}
+
+// ClassAt returns the Sierra class deployed at address.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - blockID: the block to read at (unused, the backend only tracks latest state)
+// - address: the contract address
+// Returns:
+// - rpc.ContractClass: the deployed class
+// - error: an error, if the address has not been deployed or its class was not declared
+func (b *Backend) ClassAt(ctx context.Context, blockID rpc.BlockID, address *felt.Felt) (rpc.ContractClass, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_getClassAt", blockID, address)
+	hash, ok := b.classHash[*address]
+	if !ok {
+		return rpc.ContractClass{}, rpc.ErrContractNotFound
+	}
+	class, ok := b.classes[*hash]
+	if !ok {
+		return rpc.ContractClass{}, rpc.ErrClassHashNotFound
+	}
+	return class, nil
+}
+
+// EstimateFee returns the configured FeeEstimate, once per requested
+// transaction, regardless of the transactions' actual content.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - requests: the transactions to "estimate"
+// - simulationFlags: unused, present for signature parity with rpc.Provider's methods
+// - blockID: unused, present for signature parity with rpc.Provider's methods
+// Returns:
+// - []rpc.FeeEstimate: one configured estimate per request
+// - error: an error, if any
+func (b *Backend) EstimateFee(ctx context.Context, requests []rpc.BroadcastTxnType, simulationFlags []rpc.SimulationFlag, blockID rpc.BlockID) ([]rpc.FeeEstimate, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_estimateFee", requests, simulationFlags, blockID)
+	estimates := make([]rpc.FeeEstimate, len(requests))
+	for i := range estimates {
+		estimates[i] = b.feeEstimate
+	}
+	return estimates, nil
+}
+
+// Call returns the configured call result, regardless of the function
+// called: the backend has no contract VM to evaluate a real entry point
+// against. Use SetCallResult to configure what a test expects back.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - call: the function call (unused beyond recording, the backend cannot evaluate it)
+// - blockID: unused, present for signature parity with rpc.Provider's methods
+// Returns:
+// - []*felt.Felt: the configured result
+// - error: an error, if any
+func (b *Backend) Call(ctx context.Context, call rpc.FunctionCall, blockID rpc.BlockID) ([]*felt.Felt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_call", call, blockID)
+	return b.callResult, nil
+}
+
+// AddInvokeTransaction records invokeTxn as if it had been broadcast via
+// starknet_addInvokeTransaction, and assigns it the next sequence-number
+// transaction hash.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - invokeTxn: the version-specific invoke transaction to "broadcast"
+// Returns:
+// - *rpc.AddInvokeTransactionResponse: the assigned transaction hash
+// - error: an error, if any
+func (b *Backend) AddInvokeTransaction(ctx context.Context, invokeTxn rpc.BroadcastInvokeTxnType) (*rpc.AddInvokeTransactionResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_addInvokeTransaction", invokeTxn)
+	return &rpc.AddInvokeTransactionResponse{TransactionHash: b.nextTxnHash()}, nil
+}
+
+// AddDeclareTransaction records declareTxn as if it had been broadcast via
+// starknet_addDeclareTransaction, and returns the class hash it carries with
+// the next sequence-number transaction hash. It does not register the class:
+// the class body is not known from the broadcast transaction alone (only its
+// hash is), so ClassAt resolves it only once DeclareClass has seeded it.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - declareTxn: the version-specific declare transaction to "broadcast"
+// Returns:
+// - *rpc.AddDeclareTransactionResponse: the assigned transaction hash and declared class hash
+// - error: an error, if any
+func (b *Backend) AddDeclareTransaction(ctx context.Context, declareTxn rpc.BroadcastDeclareTxnType) (*rpc.AddDeclareTransactionResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_addDeclareTransaction", declareTxn)
+
+	var classHash *felt.Felt
+	switch txn := declareTxn.(type) {
+	case rpc.DeclareTxnV1:
+		classHash = txn.ClassHash
+	case rpc.DeclareTxnV2:
+		classHash = txn.ClassHash
+	case rpc.DeclareTxnV3:
+		classHash = txn.ClassHash
+	}
+
+	return &rpc.AddDeclareTransactionResponse{
+		TransactionHash: b.nextTxnHash(),
+		ClassHash:       classHash,
+	}, nil
+}
+
+// AddDeployAccountTransaction deploys deployAccountTxn's class hash at its
+// precomputed contract address, as if it had been broadcast via
+// starknet_addDeployAccountTransaction, and assigns it the next
+// sequence-number transaction hash. The contract address is computed with
+// contracts.PrecomputeAddress, the same derivation a real node performs, so
+// it matches what ClassHashAt/StorageAt would report for a genuinely
+// deployed account.
+//
+// Parameters:
+// - ctx: the context (unused, present for signature parity with rpc.Provider's methods)
+// - deployAccountTxn: the version-specific deploy account transaction to "broadcast"
+// Returns:
+// - *rpc.AddDeployAccountTransactionResponse: the assigned transaction hash and deployed contract address
+// - error: an error, if any
+func (b *Backend) AddDeployAccountTransaction(ctx context.Context, deployAccountTxn rpc.BroadcastDeployAccountTxnType) (*rpc.AddDeployAccountTransactionResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.record("starknet_addDeployAccountTransaction", deployAccountTxn)
+
+	var classHash, salt *felt.Felt
+	var constructorCalldata []*felt.Felt
+	switch txn := deployAccountTxn.(type) {
+	case rpc.DeployAccountTxn:
+		classHash, salt, constructorCalldata = txn.ClassHash, txn.ContractAddressSalt, txn.ConstructorCalldata
+	case rpc.BroadcastDeployAccountTxnV3:
+		classHash, salt, constructorCalldata = txn.ClassHash, txn.ContractAddressSalt, txn.ConstructorCalldata
+	}
+
+	address := contracts.PrecomputeAddress(&felt.Zero, salt, classHash, constructorCalldata)
+	b.classHash[*address] = classHash
+	if _, ok := b.nonces[*address]; !ok {
+		b.nonces[*address] = &felt.Zero
+	}
+
+	return &rpc.AddDeployAccountTransactionResponse{
+		TransactionHash: b.nextTxnHash(),
+		ContractAddress: address,
+	}, nil
+}
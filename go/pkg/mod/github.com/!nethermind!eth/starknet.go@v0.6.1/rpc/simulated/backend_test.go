@@ -0,0 +1,200 @@
+package simulated
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/contracts"
+	"github.com/NethermindEth/starknet.go/rpc"
+	"github.com/NethermindEth/starknet.go/utils"
+	"github.com/test-go/testify/require"
+)
+
+// TestBackendClassLifecycle tests declaring a class, deploying it at an
+// address, and reading it back via ClassHashAt/ClassAt, entirely offline.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendClassLifecycle(t *testing.T) {
+	backend := NewBackend(time.Unix(0, 0))
+
+	address := utils.TestHexToFelt(t, "0xdeadbeef")
+	classHash := utils.TestHexToFelt(t, "0xc1a55")
+	class := rpc.ContractClass{ContractClassVersion: "0.1.0"}
+
+	backend.DeclareClass(classHash, class)
+	backend.DeployAccount(address, classHash)
+	backend.Commit()
+
+	gotHash, err := backend.ClassHashAt(context.Background(), rpc.BlockID{}, address)
+	require.Nil(t, err)
+	require.Equal(t, classHash, gotHash)
+
+	gotClass, err := backend.ClassAt(context.Background(), rpc.BlockID{}, address)
+	require.Nil(t, err)
+	require.Equal(t, class, gotClass)
+
+	nonce, err := backend.Nonce(context.Background(), rpc.BlockID{}, address)
+	require.Nil(t, err)
+	require.Equal(t, &felt.Zero, nonce)
+}
+
+// TestBackendEstimateFeeConfigurable tests that EstimateFee returns whatever
+// FeeEstimate was configured via SetFeeEstimate, once per requested txn.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendEstimateFeeConfigurable(t *testing.T) {
+	backend := NewBackend(time.Unix(0, 0))
+
+	configured := rpc.FeeEstimate{
+		GasConsumed: utils.TestHexToFelt(t, "0x1"),
+		GasPrice:    utils.TestHexToFelt(t, "0x2"),
+		OverallFee:  utils.TestHexToFelt(t, "0x2"),
+		FeeUnit:     rpc.UnitFri,
+	}
+	backend.SetFeeEstimate(configured)
+
+	estimates, err := backend.EstimateFee(context.Background(), []rpc.BroadcastTxnType{
+		rpc.InvokeTxnV0{Type: rpc.TransactionType_Invoke, Version: rpc.TransactionV0},
+		rpc.InvokeTxnV0{Type: rpc.TransactionType_Invoke, Version: rpc.TransactionV0},
+	}, nil, rpc.BlockID{})
+	require.Nil(t, err)
+	require.Len(t, estimates, 2)
+	require.Equal(t, configured, estimates[0])
+	require.Equal(t, configured, estimates[1])
+
+	logs := backend.CallLogs()
+	require.Len(t, logs, 1)
+	require.Equal(t, "starknet_estimateFee", logs[0].Method)
+}
+
+// TestBackendAddInvokeTransactionAssignsIncreasingHashes tests that
+// AddInvokeTransaction hands out distinct, increasing transaction hashes and
+// records the call.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendAddInvokeTransactionAssignsIncreasingHashes(t *testing.T) {
+	backend := NewBackend(time.Unix(0, 0))
+
+	invokeTxn := rpc.InvokeTxnV0{Type: rpc.TransactionType_Invoke, Version: rpc.TransactionV0}
+	first, err := backend.AddInvokeTransaction(context.Background(), invokeTxn)
+	require.Nil(t, err)
+	second, err := backend.AddInvokeTransaction(context.Background(), invokeTxn)
+	require.Nil(t, err)
+
+	require.NotEqual(t, first.TransactionHash, second.TransactionHash)
+
+	logs := backend.CallLogs()
+	require.Len(t, logs, 2)
+	require.Equal(t, "starknet_addInvokeTransaction", logs[0].Method)
+}
+
+// TestBackendAddDeclareTransactionRegistersClassHash tests that
+// AddDeclareTransaction returns the class hash carried by the declare
+// transaction, across every declare version.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendAddDeclareTransactionRegistersClassHash(t *testing.T) {
+	backend := NewBackend(time.Unix(0, 0))
+	classHash := utils.TestHexToFelt(t, "0xc1a55")
+
+	testSet := []rpc.BroadcastDeclareTxnType{
+		rpc.DeclareTxnV1{Type: rpc.TransactionType_Declare, Version: rpc.TransactionV1, ClassHash: classHash},
+		rpc.DeclareTxnV2{Type: rpc.TransactionType_Declare, Version: rpc.TransactionV2, ClassHash: classHash},
+		rpc.DeclareTxnV3{Type: rpc.TransactionType_Declare, Version: rpc.TransactionV3, ClassHash: classHash},
+	}
+
+	for _, declareTxn := range testSet {
+		resp, err := backend.AddDeclareTransaction(context.Background(), declareTxn)
+		require.Nil(t, err)
+		require.Equal(t, classHash, resp.ClassHash)
+	}
+}
+
+// TestBackendAddDeployAccountTransactionComputesRealAddress tests that
+// AddDeployAccountTransaction deploys the class at the same address
+// contracts.PrecomputeAddress would compute, and that the deployed address
+// is immediately readable via ClassHashAt.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendAddDeployAccountTransactionComputesRealAddress(t *testing.T) {
+	backend := NewBackend(time.Unix(0, 0))
+
+	classHash := utils.TestHexToFelt(t, "0xc1a55")
+	salt := utils.TestHexToFelt(t, "0x1")
+
+	deployAccountTxn := rpc.DeployAccountTxn{
+		Type:                rpc.TransactionType_DeployAccount,
+		Version:             rpc.TransactionV1,
+		ClassHash:           classHash,
+		ContractAddressSalt: salt,
+	}
+
+	resp, err := backend.AddDeployAccountTransaction(context.Background(), deployAccountTxn)
+	require.Nil(t, err)
+	require.Equal(t, contracts.PrecomputeAddress(&felt.Zero, salt, classHash, nil), resp.ContractAddress)
+
+	gotHash, err := backend.ClassHashAt(context.Background(), rpc.BlockID{}, resp.ContractAddress)
+	require.Nil(t, err)
+	require.Equal(t, classHash, gotHash)
+}
+
+// TestBackendCallReturnsConfiguredResult tests that Call returns whatever
+// result was configured via SetCallResult, regardless of the call's target.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendCallReturnsConfiguredResult(t *testing.T) {
+	backend := NewBackend(time.Unix(0, 0))
+
+	configured := []*felt.Felt{utils.TestHexToFelt(t, "0x2a")}
+	backend.SetCallResult(configured)
+
+	result, err := backend.Call(context.Background(), rpc.FunctionCall{
+		ContractAddress:    utils.TestHexToFelt(t, "0xdeadbeef"),
+		EntryPointSelector: utils.TestHexToFelt(t, "0x1"),
+	}, rpc.BlockID{})
+	require.Nil(t, err)
+	require.Equal(t, configured, result)
+}
+
+// TestBackendAdjustTimeAndCommit tests that AdjustTime moves the clock
+// without mining, while Commit increments the block number.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestBackendAdjustTimeAndCommit(t *testing.T) {
+	backend := NewBackend(time.Unix(100, 0))
+	backend.AdjustTime(time.Hour)
+	require.Equal(t, time.Unix(100, 0).Add(time.Hour), backend.blockTime)
+
+	require.Equal(t, uint64(1), backend.Commit())
+	require.Equal(t, uint64(2), backend.Commit())
+}
@@ -0,0 +1,303 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/rpc/types"
+)
+
+// BroadcastInvokeTxnType is implemented by every invoke transaction version
+// that can be submitted through starknet_addInvokeTransaction.
+type BroadcastInvokeTxnType interface {
+	InvokeVersion() TransactionVersion
+}
+
+// BroadcastDeclareTxnType is implemented by every declare transaction
+// version that can be submitted through starknet_addDeclareTransaction.
+type BroadcastDeclareTxnType interface {
+	DeclareVersion() TransactionVersion
+}
+
+// BroadcastDeployAccountTxnType is implemented by every deploy account
+// transaction version that can be submitted through
+// starknet_addDeployAccountTransaction.
+type BroadcastDeployAccountTxnType interface {
+	DeployAccountVersion() TransactionVersion
+}
+
+// ResourceBounds and ResourceBoundsMapping now live in rpc/types; these
+// aliases keep every existing v3 broadcast txn field and call site in this
+// package compiling unchanged.
+type ResourceBounds = types.ResourceBounds
+type ResourceBoundsMapping = types.ResourceBoundsMapping
+
+// BroadcastInvokeTxnV0 is a v0 invoke transaction, addressed directly at an
+// entry point rather than through account __execute__.
+type BroadcastInvokeTxnV0 struct {
+	Type               TransactionType    `json:"type"`
+	Version            TransactionVersion `json:"version"`
+	MaxFee             *felt.Felt         `json:"max_fee"`
+	Signature          []*felt.Felt       `json:"signature"`
+	ContractAddress    *felt.Felt         `json:"contract_address"`
+	EntryPointSelector *felt.Felt         `json:"entry_point_selector"`
+	Calldata           []*felt.Felt       `json:"calldata"`
+}
+
+// InvokeVersion implements BroadcastInvokeTxnType.
+func (txn BroadcastInvokeTxnV0) InvokeVersion() TransactionVersion { return TransactionV0 }
+
+// BroadcastInvokeTxnV1 is a v1 invoke transaction, using max_fee and a nonce.
+type BroadcastInvokeTxnV1 struct {
+	Type          TransactionType    `json:"type"`
+	Version       TransactionVersion `json:"version"`
+	MaxFee        *felt.Felt         `json:"max_fee"`
+	Signature     []*felt.Felt       `json:"signature"`
+	Nonce         *felt.Felt         `json:"nonce"`
+	SenderAddress *felt.Felt         `json:"sender_address"`
+	Calldata      []*felt.Felt       `json:"calldata"`
+}
+
+// InvokeVersion implements BroadcastInvokeTxnType.
+func (txn BroadcastInvokeTxnV1) InvokeVersion() TransactionVersion { return TransactionV1 }
+
+// BroadcastInvokeTxnV3 is a v3 invoke transaction, using resource_bounds and
+// tip instead of the legacy max_fee field.
+type BroadcastInvokeTxnV3 struct {
+	Type                      TransactionType       `json:"type"`
+	Version                   TransactionVersion    `json:"version"`
+	SenderAddress             *felt.Felt            `json:"sender_address"`
+	Calldata                  []*felt.Felt          `json:"calldata"`
+	Signature                 []*felt.Felt          `json:"signature"`
+	Nonce                     *felt.Felt            `json:"nonce"`
+	ResourceBounds            ResourceBoundsMapping `json:"resource_bounds"`
+	Tip                       string                `json:"tip"`
+	PaymasterData             []*felt.Felt          `json:"paymaster_data"`
+	AccountDeploymentData     []*felt.Felt          `json:"account_deployment_data"`
+	NonceDataAvailabilityMode string                `json:"nonce_data_availability_mode"`
+	FeeDataAvailabilityMode   string                `json:"fee_data_availability_mode"`
+}
+
+// InvokeVersion implements BroadcastInvokeTxnType.
+func (txn BroadcastInvokeTxnV3) InvokeVersion() TransactionVersion { return TransactionV3 }
+
+// BroadcastInvokeTxnEnvelope decodes a JSON invoke transaction body into the
+// concrete v0/v1/v3 struct its "version" field names, so AddInvokeTransaction
+// and any caller reading a persisted payload gets back a properly typed
+// BroadcastInvokeTxnType instead of a partially-populated generic struct.
+type BroadcastInvokeTxnEnvelope struct {
+	Txn BroadcastInvokeTxnType
+}
+
+// UnmarshalJSON implements json.Unmarshaler, peeking at the version field
+// before deciding which concrete invoke txn struct to decode into.
+func (e *BroadcastInvokeTxnEnvelope) UnmarshalJSON(data []byte) error {
+	var versionPeek struct {
+		Version TransactionVersion `json:"version"`
+	}
+	if err := json.Unmarshal(data, &versionPeek); err != nil {
+		return err
+	}
+
+	switch versionPeek.Version {
+	case TransactionV0:
+		var txn BroadcastInvokeTxnV0
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	case TransactionV1:
+		var txn BroadcastInvokeTxnV1
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	case TransactionV3:
+		var txn BroadcastInvokeTxnV3
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	default:
+		return fmt.Errorf("unknown invoke transaction version %q", versionPeek.Version)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, delegating to the wrapped concrete
+// transaction so the envelope round-trips transparently.
+func (e BroadcastInvokeTxnEnvelope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Txn)
+}
+
+// BroadcastDeclareTxnV1 is a v1 declare transaction for a deprecated
+// (Cairo 0) contract class.
+type BroadcastDeclareTxnV1 struct {
+	Type          TransactionType    `json:"type"`
+	Version       TransactionVersion `json:"version"`
+	MaxFee        *felt.Felt         `json:"max_fee"`
+	Signature     []*felt.Felt       `json:"signature"`
+	Nonce         *felt.Felt         `json:"nonce"`
+	ClassHash     *felt.Felt         `json:"class_hash"`
+	SenderAddress *felt.Felt         `json:"sender_address"`
+}
+
+// DeclareVersion implements BroadcastDeclareTxnType.
+func (txn BroadcastDeclareTxnV1) DeclareVersion() TransactionVersion { return TransactionV1 }
+
+// BroadcastDeclareTxnV2 is a v2 declare transaction for a Sierra (Cairo 1)
+// contract class, carrying the compiled class hash alongside the Sierra one.
+type BroadcastDeclareTxnV2 struct {
+	Type                TransactionType    `json:"type"`
+	Version             TransactionVersion `json:"version"`
+	MaxFee              *felt.Felt         `json:"max_fee"`
+	Signature           []*felt.Felt       `json:"signature"`
+	Nonce               *felt.Felt         `json:"nonce"`
+	ClassHash           *felt.Felt         `json:"class_hash"`
+	CompiledClassHash   *felt.Felt         `json:"compiled_class_hash"`
+	SenderAddress       *felt.Felt         `json:"sender_address"`
+}
+
+// DeclareVersion implements BroadcastDeclareTxnType.
+func (txn BroadcastDeclareTxnV2) DeclareVersion() TransactionVersion { return TransactionV2 }
+
+// BroadcastDeclareTxnV3 is a v3 declare transaction, using resource_bounds
+// and tip instead of the legacy max_fee field.
+type BroadcastDeclareTxnV3 struct {
+	Type                      TransactionType       `json:"type"`
+	Version                   TransactionVersion    `json:"version"`
+	SenderAddress             *felt.Felt            `json:"sender_address"`
+	Signature                 []*felt.Felt          `json:"signature"`
+	Nonce                     *felt.Felt            `json:"nonce"`
+	ClassHash                 *felt.Felt            `json:"class_hash"`
+	CompiledClassHash         *felt.Felt            `json:"compiled_class_hash"`
+	ResourceBounds            ResourceBoundsMapping `json:"resource_bounds"`
+	Tip                       string                `json:"tip"`
+	PaymasterData             []*felt.Felt          `json:"paymaster_data"`
+	AccountDeploymentData     []*felt.Felt          `json:"account_deployment_data"`
+	NonceDataAvailabilityMode string                `json:"nonce_data_availability_mode"`
+	FeeDataAvailabilityMode   string                `json:"fee_data_availability_mode"`
+}
+
+// DeclareVersion implements BroadcastDeclareTxnType.
+func (txn BroadcastDeclareTxnV3) DeclareVersion() TransactionVersion { return TransactionV3 }
+
+// BroadcastDeclareTxnEnvelope decodes a JSON declare transaction body into
+// the concrete v1/v2/v3 struct its "version" field names.
+type BroadcastDeclareTxnEnvelope struct {
+	Txn BroadcastDeclareTxnType
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *BroadcastDeclareTxnEnvelope) UnmarshalJSON(data []byte) error {
+	var versionPeek struct {
+		Version TransactionVersion `json:"version"`
+	}
+	if err := json.Unmarshal(data, &versionPeek); err != nil {
+		return err
+	}
+
+	switch versionPeek.Version {
+	case TransactionV1:
+		var txn BroadcastDeclareTxnV1
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	case TransactionV2:
+		var txn BroadcastDeclareTxnV2
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	case TransactionV3:
+		var txn BroadcastDeclareTxnV3
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	default:
+		return fmt.Errorf("unknown declare transaction version %q", versionPeek.Version)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e BroadcastDeclareTxnEnvelope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Txn)
+}
+
+// BroadcastDeployAccountTxnV1 is a v1 deploy account transaction.
+type BroadcastDeployAccountTxnV1 struct {
+	Type                TransactionType    `json:"type"`
+	Version             TransactionVersion `json:"version"`
+	MaxFee              *felt.Felt         `json:"max_fee"`
+	Signature           []*felt.Felt       `json:"signature"`
+	Nonce               *felt.Felt         `json:"nonce"`
+	ContractAddressSalt *felt.Felt         `json:"contract_address_salt"`
+	ConstructorCalldata []*felt.Felt       `json:"constructor_calldata"`
+	ClassHash           *felt.Felt         `json:"class_hash"`
+}
+
+// DeployAccountVersion implements BroadcastDeployAccountTxnType.
+func (txn BroadcastDeployAccountTxnV1) DeployAccountVersion() TransactionVersion { return TransactionV1 }
+
+// BroadcastDeployAccountTxnV3 is a v3 deploy account transaction, using
+// resource_bounds and tip instead of the legacy max_fee field.
+type BroadcastDeployAccountTxnV3 struct {
+	Type                      TransactionType       `json:"type"`
+	Version                   TransactionVersion    `json:"version"`
+	Signature                 []*felt.Felt          `json:"signature"`
+	Nonce                     *felt.Felt            `json:"nonce"`
+	ContractAddressSalt      *felt.Felt            `json:"contract_address_salt"`
+	ConstructorCalldata       []*felt.Felt          `json:"constructor_calldata"`
+	ClassHash                 *felt.Felt            `json:"class_hash"`
+	ResourceBounds            ResourceBoundsMapping `json:"resource_bounds"`
+	Tip                       string                `json:"tip"`
+	PaymasterData             []*felt.Felt          `json:"paymaster_data"`
+	NonceDataAvailabilityMode string                `json:"nonce_data_availability_mode"`
+	FeeDataAvailabilityMode   string                `json:"fee_data_availability_mode"`
+}
+
+// DeployAccountVersion implements BroadcastDeployAccountTxnType.
+func (txn BroadcastDeployAccountTxnV3) DeployAccountVersion() TransactionVersion { return TransactionV3 }
+
+// BroadcastDeployAccountTxnEnvelope decodes a JSON deploy account
+// transaction body into the concrete v1/v3 struct its "version" field names.
+type BroadcastDeployAccountTxnEnvelope struct {
+	Txn BroadcastDeployAccountTxnType
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *BroadcastDeployAccountTxnEnvelope) UnmarshalJSON(data []byte) error {
+	var versionPeek struct {
+		Version TransactionVersion `json:"version"`
+	}
+	if err := json.Unmarshal(data, &versionPeek); err != nil {
+		return err
+	}
+
+	switch versionPeek.Version {
+	case TransactionV1:
+		var txn BroadcastDeployAccountTxnV1
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	case TransactionV3:
+		var txn BroadcastDeployAccountTxnV3
+		if err := json.Unmarshal(data, &txn); err != nil {
+			return err
+		}
+		e.Txn = txn
+	default:
+		return fmt.Errorf("unknown deploy account transaction version %q", versionPeek.Version)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e BroadcastDeployAccountTxnEnvelope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Txn)
+}
@@ -0,0 +1,231 @@
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/NethermindEth/starknet.go/utils"
+	"github.com/test-go/testify/require"
+)
+
+// TestBroadcastInvokeTxnEnvelopeRoundTrip tests that a BroadcastInvokeTxnEnvelope
+// decodes each invoke transaction version into its matching concrete struct and
+// re-encodes it losslessly.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+// none
+func TestBroadcastInvokeTxnEnvelopeRoundTrip(t *testing.T) {
+	type testSetType struct {
+		Txn          BroadcastInvokeTxnType
+		ExpectedType any
+	}
+
+	testSet := []testSetType{
+		{
+			Txn: BroadcastInvokeTxnV0{
+				Type:               TransactionType_Invoke,
+				Version:            TransactionV0,
+				MaxFee:             utils.TestHexToFelt(t, "0x1"),
+				Signature:          []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				ContractAddress:    utils.TestHexToFelt(t, "0x3"),
+				EntryPointSelector: utils.TestHexToFelt(t, "0x4"),
+				Calldata:           []*felt.Felt{utils.TestHexToFelt(t, "0x5")},
+			},
+			ExpectedType: BroadcastInvokeTxnV0{},
+		},
+		{
+			Txn: BroadcastInvokeTxnV1{
+				Type:          TransactionType_Invoke,
+				Version:       TransactionV1,
+				MaxFee:        utils.TestHexToFelt(t, "0x1"),
+				Signature:     []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				Nonce:         utils.TestHexToFelt(t, "0x3"),
+				SenderAddress: utils.TestHexToFelt(t, "0x4"),
+				Calldata:      []*felt.Felt{utils.TestHexToFelt(t, "0x5")},
+			},
+			ExpectedType: BroadcastInvokeTxnV1{},
+		},
+		{
+			Txn: BroadcastInvokeTxnV3{
+				Type:          TransactionType_Invoke,
+				Version:       TransactionV3,
+				SenderAddress: utils.TestHexToFelt(t, "0x1"),
+				Calldata:      []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				Signature:     []*felt.Felt{utils.TestHexToFelt(t, "0x3")},
+				Nonce:         utils.TestHexToFelt(t, "0x4"),
+				ResourceBounds: ResourceBoundsMapping{
+					L1Gas: ResourceBounds{MaxAmount: "0x1", MaxPricePerUnit: "0x2"},
+					L2Gas: ResourceBounds{MaxAmount: "0x3", MaxPricePerUnit: "0x4"},
+				},
+				Tip: "0x0",
+			},
+			ExpectedType: BroadcastInvokeTxnV3{},
+		},
+	}
+
+	for _, test := range testSet {
+		encoded, err := json.Marshal(test.Txn)
+		require.NoError(t, err)
+
+		var envelope BroadcastInvokeTxnEnvelope
+		require.NoError(t, json.Unmarshal(encoded, &envelope))
+		require.IsType(t, test.ExpectedType, envelope.Txn)
+		require.Equal(t, test.Txn, envelope.Txn)
+
+		reEncoded, err := json.Marshal(envelope)
+		require.NoError(t, err)
+		require.JSONEq(t, string(encoded), string(reEncoded))
+	}
+}
+
+// TestBroadcastDeclareTxnEnvelopeRoundTrip tests that a BroadcastDeclareTxnEnvelope
+// decodes each declare transaction version into its matching concrete struct.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+// none
+func TestBroadcastDeclareTxnEnvelopeRoundTrip(t *testing.T) {
+	type testSetType struct {
+		Txn          BroadcastDeclareTxnType
+		ExpectedType any
+	}
+
+	testSet := []testSetType{
+		{
+			Txn: BroadcastDeclareTxnV1{
+				Type:          TransactionType_Declare,
+				Version:       TransactionV1,
+				MaxFee:        utils.TestHexToFelt(t, "0x1"),
+				Signature:     []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				Nonce:         utils.TestHexToFelt(t, "0x3"),
+				ClassHash:     utils.TestHexToFelt(t, "0x4"),
+				SenderAddress: utils.TestHexToFelt(t, "0x5"),
+			},
+			ExpectedType: BroadcastDeclareTxnV1{},
+		},
+		{
+			Txn: BroadcastDeclareTxnV2{
+				Type:              TransactionType_Declare,
+				Version:           TransactionV2,
+				MaxFee:            utils.TestHexToFelt(t, "0x1"),
+				Signature:         []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				Nonce:             utils.TestHexToFelt(t, "0x3"),
+				ClassHash:         utils.TestHexToFelt(t, "0x4"),
+				CompiledClassHash: utils.TestHexToFelt(t, "0x5"),
+				SenderAddress:     utils.TestHexToFelt(t, "0x6"),
+			},
+			ExpectedType: BroadcastDeclareTxnV2{},
+		},
+		{
+			Txn: BroadcastDeclareTxnV3{
+				Type:              TransactionType_Declare,
+				Version:           TransactionV3,
+				SenderAddress:     utils.TestHexToFelt(t, "0x1"),
+				Signature:         []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				Nonce:             utils.TestHexToFelt(t, "0x3"),
+				ClassHash:         utils.TestHexToFelt(t, "0x4"),
+				CompiledClassHash: utils.TestHexToFelt(t, "0x5"),
+				ResourceBounds: ResourceBoundsMapping{
+					L1Gas: ResourceBounds{MaxAmount: "0x1", MaxPricePerUnit: "0x2"},
+					L2Gas: ResourceBounds{MaxAmount: "0x3", MaxPricePerUnit: "0x4"},
+				},
+				Tip: "0x0",
+			},
+			ExpectedType: BroadcastDeclareTxnV3{},
+		},
+	}
+
+	for _, test := range testSet {
+		encoded, err := json.Marshal(test.Txn)
+		require.NoError(t, err)
+
+		var envelope BroadcastDeclareTxnEnvelope
+		require.NoError(t, json.Unmarshal(encoded, &envelope))
+		require.IsType(t, test.ExpectedType, envelope.Txn)
+		require.Equal(t, test.Txn, envelope.Txn)
+
+		reEncoded, err := json.Marshal(envelope)
+		require.NoError(t, err)
+		require.JSONEq(t, string(encoded), string(reEncoded))
+	}
+}
+
+// TestBroadcastDeployAccountTxnEnvelopeRoundTrip tests that a
+// BroadcastDeployAccountTxnEnvelope decodes each deploy account transaction
+// version into its matching concrete struct.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+// none
+func TestBroadcastDeployAccountTxnEnvelopeRoundTrip(t *testing.T) {
+	type testSetType struct {
+		Txn          BroadcastDeployAccountTxnType
+		ExpectedType any
+	}
+
+	testSet := []testSetType{
+		{
+			Txn: BroadcastDeployAccountTxnV1{
+				Type:                TransactionType_DeployAccount,
+				Version:             TransactionV1,
+				MaxFee:              utils.TestHexToFelt(t, "0x1"),
+				Signature:           []*felt.Felt{utils.TestHexToFelt(t, "0x2")},
+				Nonce:               utils.TestHexToFelt(t, "0x3"),
+				ContractAddressSalt: utils.TestHexToFelt(t, "0x4"),
+				ConstructorCalldata: []*felt.Felt{utils.TestHexToFelt(t, "0x5")},
+				ClassHash:           utils.TestHexToFelt(t, "0x6"),
+			},
+			ExpectedType: BroadcastDeployAccountTxnV1{},
+		},
+		{
+			Txn: BroadcastDeployAccountTxnV3{
+				Type:                TransactionType_DeployAccount,
+				Version:             TransactionV3,
+				Signature:           []*felt.Felt{utils.TestHexToFelt(t, "0x1")},
+				Nonce:               utils.TestHexToFelt(t, "0x2"),
+				ContractAddressSalt: utils.TestHexToFelt(t, "0x3"),
+				ConstructorCalldata: []*felt.Felt{utils.TestHexToFelt(t, "0x4")},
+				ClassHash:           utils.TestHexToFelt(t, "0x5"),
+				ResourceBounds: ResourceBoundsMapping{
+					L1Gas: ResourceBounds{MaxAmount: "0x1", MaxPricePerUnit: "0x2"},
+					L2Gas: ResourceBounds{MaxAmount: "0x3", MaxPricePerUnit: "0x4"},
+				},
+				Tip: "0x0",
+			},
+			ExpectedType: BroadcastDeployAccountTxnV3{},
+		},
+	}
+
+	for _, test := range testSet {
+		encoded, err := json.Marshal(test.Txn)
+		require.NoError(t, err)
+
+		var envelope BroadcastDeployAccountTxnEnvelope
+		require.NoError(t, json.Unmarshal(encoded, &envelope))
+		require.IsType(t, test.ExpectedType, envelope.Txn)
+		require.Equal(t, test.Txn, envelope.Txn)
+
+		reEncoded, err := json.Marshal(envelope)
+		require.NoError(t, err)
+		require.JSONEq(t, string(encoded), string(reEncoded))
+	}
+}
+
+// TestBroadcastInvokeTxnEnvelopeUnknownVersion tests that decoding an invoke
+// transaction with an unrecognised version returns an error instead of
+// silently producing a nil Txn.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+// none
+func TestBroadcastInvokeTxnEnvelopeUnknownVersion(t *testing.T) {
+	var envelope BroadcastInvokeTxnEnvelope
+	err := json.Unmarshal([]byte(`{"type":"INVOKE","version":"0x9"}`), &envelope)
+	require.Error(t, err)
+}
@@ -0,0 +1,212 @@
+// Package client holds the JSON-RPC transport abstraction and the
+// retry/rate-limit/timeout middleware that wraps it, independent of the
+// request/response DTOs (rpc/types) and the error surface (rpc/errors) they
+// both depend on. This lets a downstream consumer depend on just the
+// transport (e.g. to write its own middleware) without pulling in the DTOs.
+//
+// Provider and NewProvider, which would apply these options when dialing a
+// real node, are not part of this snapshot — their defining file isn't
+// vendored here, only call sites that reference them (e.g. the rpc
+// package's own tests, via the otherwise-undefined beforeEach/testConfig).
+// Once NewProvider is available, it should accept ...ClientOption and set
+// its transport field to Chain(transport, opts...), the same pattern
+// WithRetry/WithRateLimit/WithRequestTimeout are already built around.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"github.com/NethermindEth/starknet.go/rpc/errors"
+	"golang.org/x/time/rate"
+)
+
+// CallCloser is the minimal JSON-RPC transport Provider depends on: a
+// single call, context-aware method. Defining it as an interface (rather
+// than depending on a concrete HTTP client) is what lets NewSpy and the
+// retry/rate-limit/timeout middleware in this package wrap or intercept the
+// transport transparently.
+type CallCloser interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+}
+
+// ClientOption wraps a CallCloser with additional behaviour (retry,
+// rate-limiting, timeouts) before it reaches the JSON-RPC transport used by
+// TransactionByHash, TransactionReceipt, GetTransactionStatus, and friends.
+type ClientOption func(CallCloser) CallCloser
+
+// Chain applies every ClientOption to base, in order, so the first option
+// wraps innermost and the last wraps outermost.
+//
+// This is the shape a Provider constructor should thread through: dial the
+// raw transport, then set provider.c = Chain(transport, opts...) with
+// whatever ClientOptions the caller passed to NewProvider. The constructor
+// that does this isn't part of this snapshot (NewProvider's defining file
+// isn't vendored here — see the package doc), so that wiring can't be
+// edited directly; TestChainComposesRetryAndRateLimit in client_test.go
+// pins that multiple options compose in the order a constructor would apply
+// them.
+//
+// Parameters:
+// - base: the underlying transport to wrap
+// - opts: the middleware to apply, applied in order
+// Returns:
+// - CallCloser: the wrapped transport
+func Chain(base CallCloser, opts ...ClientOption) CallCloser {
+	wrapped := base
+	for _, opt := range opts {
+		wrapped = opt(wrapped)
+	}
+	return wrapped
+}
+
+// RetryPolicy controls WithRetry's exponential backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a call is attempted, including the first try.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay between retries.
+	MaxDelay time.Duration
+	// RetryHashNotFound opts a caller into retrying ErrHashNotFound, useful
+	// right after AddInvokeTransaction while the node hasn't indexed the
+	// transaction yet. Off by default since most ErrHashNotFound cases mean
+	// the hash is simply wrong.
+	RetryHashNotFound bool
+}
+
+// isRetryable classifies an error returned by the transport as safe to
+// retry. Transport/5xx errors and ErrUnexpectedError are always retried;
+// ErrHashNotFound retries only when the policy opts in; everything else
+// (ErrDuplicateTx, ErrInvalidTransactionNonce,
+// ErrInsufficientAccountBalance, and any other RPCError) is never retried,
+// since retrying a rejected transaction just resubmits the same rejection.
+//
+// The middleware sits below any caller-side tryUnwrapToRPCErr, so err is
+// usually still the raw node JSON-RPC error body rather than an
+// already-typed *errors.RPCError; this decodes it the same way
+// tryUnwrapToRPCErr does before classifying it.
+func (p RetryPolicy) isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	rpcErr, ok := err.(*errors.RPCError)
+	if !ok {
+		rpcErr = new(errors.RPCError)
+		if jsonErr := json.Unmarshal([]byte(err.Error()), rpcErr); jsonErr != nil {
+			return true // not a node JSON-RPC error body; a dial/5xx/timeout transport error
+		}
+	}
+	switch rpcErr.Code() {
+	case errors.ErrUnexpectedError.Code():
+		return true
+	case errors.ErrHashNotFound.Code():
+		return p.RetryHashNotFound
+	case errors.ErrDuplicateTx.Code(), errors.ErrInvalidTransactionNonce.Code(), errors.ErrInsufficientAccountBalance.Code():
+		return false
+	default:
+		return false
+	}
+}
+
+type retryingClient struct {
+	CallCloser
+	policy RetryPolicy
+}
+
+// CallContext implements CallCloser, retrying retryable errors with
+// exponential backoff and full jitter up to policy.MaxAttempts times.
+func (c *retryingClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	delay := c.policy.BaseDelay
+	var err error
+	for attempt := 1; attempt <= c.policy.MaxAttempts; attempt++ {
+		err = c.CallCloser.CallContext(ctx, result, method, args...)
+		if err == nil || !c.policy.isRetryable(err) || attempt == c.policy.MaxAttempts {
+			return err
+		}
+
+		jittered := time.Duration(rand.Int63n(int64(delay)))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jittered):
+		}
+
+		delay *= 2
+		if delay > c.policy.MaxDelay {
+			delay = c.policy.MaxDelay
+		}
+	}
+	return err
+}
+
+// WithRetry wraps the transport so retryable failures (transport errors and
+// ErrUnexpectedError always, ErrHashNotFound when policy.RetryHashNotFound
+// is set) are retried with exponential backoff and jitter, up to
+// policy.MaxAttempts.
+//
+// Parameters:
+// - policy: the retry policy to apply
+// Returns:
+// - ClientOption: the middleware
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(base CallCloser) CallCloser {
+		return &retryingClient{CallCloser: base, policy: policy}
+	}
+}
+
+type rateLimitedClient struct {
+	CallCloser
+	limiter *rate.Limiter
+}
+
+// CallContext implements CallCloser, blocking until the rate limiter admits
+// the call or the context is done.
+func (c *rateLimitedClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	return c.CallCloser.CallContext(ctx, result, method, args...)
+}
+
+// WithRateLimit wraps the transport with a token-bucket limiter allowing rps
+// requests per second, with burst capacity.
+//
+// Parameters:
+// - rps: sustained requests per second
+// - burst: burst capacity above the sustained rate
+// Returns:
+// - ClientOption: the middleware
+func WithRateLimit(rps int, burst int) ClientOption {
+	return func(base CallCloser) CallCloser {
+		return &rateLimitedClient{CallCloser: base, limiter: rate.NewLimiter(rate.Limit(rps), burst)}
+	}
+}
+
+type timeoutClient struct {
+	CallCloser
+	timeout time.Duration
+}
+
+// CallContext implements CallCloser, bounding the call with a per-request
+// timeout derived from the caller's context.
+func (c *timeoutClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.CallCloser.CallContext(ctx, result, method, args...)
+}
+
+// WithRequestTimeout wraps the transport so every call is bounded by d,
+// independent of whatever deadline the caller's context already carries.
+//
+// Parameters:
+// - d: the per-request timeout
+// Returns:
+// - ClientOption: the middleware
+func WithRequestTimeout(d time.Duration) ClientOption {
+	return func(base CallCloser) CallCloser {
+		return &timeoutClient{CallCloser: base, timeout: d}
+	}
+}
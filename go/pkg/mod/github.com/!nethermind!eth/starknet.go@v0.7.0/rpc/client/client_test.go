@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	goerrors "errors"
+	"testing"
+	"time"
+
+	"github.com/NethermindEth/starknet.go/rpc/errors"
+	"github.com/test-go/testify/require"
+)
+
+type fakeClient struct {
+	calls int
+	fail  int // number of initial calls that should fail
+	err   error
+}
+
+func (f *fakeClient) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	f.calls++
+	if f.calls <= f.fail {
+		return f.err
+	}
+	return nil
+}
+
+// TestRetryingClientRetriesUnexpectedError tests that a retryable error
+// (ErrUnexpectedError) is retried until it succeeds, within MaxAttempts.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestRetryingClientRetriesUnexpectedError(t *testing.T) {
+	fake := &fakeClient{fail: 2, err: errors.ErrUnexpectedError}
+	client := Chain(fake, WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}))
+
+	err := client.CallContext(context.Background(), nil, "starknet_call")
+	require.Nil(t, err)
+	require.Equal(t, 3, fake.calls)
+}
+
+// TestRetryingClientNeverRetriesDuplicateTx tests that ErrDuplicateTx is
+// never retried, regardless of MaxAttempts.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestRetryingClientNeverRetriesDuplicateTx(t *testing.T) {
+	fake := &fakeClient{fail: 5, err: errors.ErrDuplicateTx}
+	client := Chain(fake, WithRetry(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}))
+
+	err := client.CallContext(context.Background(), nil, "starknet_addInvokeTransaction")
+	require.Equal(t, errors.ErrDuplicateTx, err)
+	require.Equal(t, 1, fake.calls)
+}
+
+// TestRetryingClientHashNotFoundOptIn tests that ErrHashNotFound is only
+// retried when RetryHashNotFound is set.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestRetryingClientHashNotFoundOptIn(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	require.False(t, policy.isRetryable(errors.ErrHashNotFound))
+
+	policy.RetryHashNotFound = true
+	require.True(t, policy.isRetryable(errors.ErrHashNotFound))
+}
+
+// TestRetryingClientNeverRetriesDuplicateTxRawError tests that a raw,
+// not-yet-unwrapped node error body (the shape CallContext actually returns,
+// below any caller-side TryUnwrapToRPCErr) is still classified as
+// non-retryable, not treated as a generic transport error.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestRetryingClientNeverRetriesDuplicateTxRawError(t *testing.T) {
+	rawDuplicateTx := goerrors.New(`{"code":59,"message":"A transaction with the same hash already exists in the mempool"}`)
+	fake := &fakeClient{fail: 5, err: rawDuplicateTx}
+	client := Chain(fake, WithRetry(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}))
+
+	err := client.CallContext(context.Background(), nil, "starknet_addInvokeTransaction")
+	require.Equal(t, rawDuplicateTx, err)
+	require.Equal(t, 1, fake.calls)
+}
+
+// TestChainComposesRetryAndRateLimit tests that WithRetry and WithRateLimit
+// compose correctly when both are passed to Chain together, the way a real
+// Provider constructor would thread a caller's ...ClientOption through: the
+// retrying wrapper must still see (and retry) the underlying transport's
+// failures with the rate limiter applied underneath it.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestChainComposesRetryAndRateLimit(t *testing.T) {
+	fake := &fakeClient{fail: 2, err: errors.ErrUnexpectedError}
+	chained := Chain(fake,
+		WithRateLimit(1000, 1000),
+		WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}),
+	)
+
+	err := chained.CallContext(context.Background(), nil, "starknet_call")
+	require.Nil(t, err)
+	require.Equal(t, 3, fake.calls)
+}
+
+// TestRetryingClientRetriesTransportError tests that a plain (non-RPCError)
+// transport error is treated as retryable.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestRetryingClientRetriesTransportError(t *testing.T) {
+	fake := &fakeClient{fail: 1, err: goerrors.New("connection reset")}
+	client := Chain(fake, WithRetry(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}))
+
+	err := client.CallContext(context.Background(), nil, "starknet_call")
+	require.Nil(t, err)
+	require.Equal(t, 2, fake.calls)
+}
@@ -0,0 +1,21 @@
+package rpc
+
+import "github.com/NethermindEth/starknet.go/rpc/client"
+
+// callCloser and the retry/rate-limit/timeout middleware used to live
+// directly in this package; they now live in rpc/client so downstream
+// consumers can depend on just the transport without pulling in the DTOs.
+// These aliases keep existing call sites (and this package's own tests)
+// compiling unchanged.
+type (
+	callCloser   = client.CallCloser
+	ClientOption = client.ClientOption
+	RetryPolicy  = client.RetryPolicy
+)
+
+var (
+	Chain              = client.Chain
+	WithRetry          = client.WithRetry
+	WithRateLimit      = client.WithRateLimit
+	WithRequestTimeout = client.WithRequestTimeout
+)
@@ -0,0 +1,377 @@
+// Package errors holds the Starknet JSON-RPC error type, its sentinel
+// values and codes, and the typed data variants each error code can carry.
+// It has no dependency on the transport or DTO packages so that downstream
+// consumers (indexers, account-abstraction libraries) can inspect or
+// compare against node errors without pulling in an HTTP client.
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// TryUnwrapToRPCErr unwraps the error and checks if it matches any of the given RPC errors.
+// If a match is found, the corresponding RPC error is returned.
+// If no match is found, the function returns an InternalError with the original error.
+//
+// Parameters:
+// - err: The error to be unwrapped
+// - rpcErrors: variadic list of *RPCError objects to be checked
+// Returns:
+// - error: the typed-data error from IsErrorWithData for codes that carry
+//   data; otherwise the matching entry of rpcErrors; otherwise
+//   Err(InternalError, err). err itself is returned unchanged when its
+//   message is not a JSON error object.
+func TryUnwrapToRPCErr(err error, rpcErrors ...*RPCError) error {
+	nodeErr := new(RPCError)
+	if jsonErr := json.Unmarshal([]byte(err.Error()), nodeErr); jsonErr != nil {
+		return err
+	}
+
+	dataErr := IsErrorWithData(nodeErr)
+	if dataErr != nil {
+		return dataErr
+	}
+
+	for _, rpcErr := range rpcErrors {
+		if errors.Is(nodeErr, rpcErr) {
+			return rpcErr
+		}
+	}
+	return Err(InternalError, err)
+}
+
+var ErrNotImplemented = errors.New("not implemented")
+
+const (
+	InvalidJSON    = -32700 // Invalid JSON was received by the server.
+	InvalidRequest = -32600 // The JSON sent is not a valid Request object.
+	MethodNotFound = -32601 // The method does not exist / is not available.
+	InvalidParams  = -32602 // Invalid method parameter(s).
+	InternalError  = -32603 // Internal JSON-RPC error.
+)
+
+// Err returns an RPCError based on the given code and data.
+//
+// Parameters:
+// - code: an integer representing the error code.
+// - data: any data associated with the error.
+// Returns
+// - *RPCError: a pointer to an RPCError object.
+func Err(code int, data any) *RPCError {
+	switch code {
+	case InvalidJSON:
+		return &RPCError{code: InvalidJSON, message: "Parse error", data: data}
+	case InvalidRequest:
+		return &RPCError{code: InvalidRequest, message: "Invalid Request", data: data}
+	case MethodNotFound:
+		return &RPCError{code: MethodNotFound, message: "Method Not Found", data: data}
+	case InvalidParams:
+		return &RPCError{code: InvalidParams, message: "Invalid Params", data: data}
+	default:
+		return &RPCError{code: InternalError, message: "Internal Error", data: data}
+	}
+}
+
+// IsErrorWithData checks if the error is the type of error that might contain information in the data field.
+// In the case it is, it adds this information to the returned error.
+//
+// Parameters:
+// - nodeErr: The error to be checked
+// Returns:
+// - *RPCError: a pointer to the RPCError resulting object
+func IsErrorWithData(nodeErr *RPCError) *RPCError {
+	switch nodeErr.code {
+	case ErrUnexpectedError.code:
+		unexpectedErr := *ErrUnexpectedError
+		unexpectedErr.data = decodeErrorData[UnexpectedErrorData](nodeErr.data)
+		return &unexpectedErr
+	case ErrNoTraceAvailable.code:
+		noTraceAvailableError := *ErrNoTraceAvailable
+		noTraceAvailableError.data = decodeErrorData[NoTraceAvailableData](nodeErr.data)
+		return &noTraceAvailableError
+	case ErrContractError.code:
+		contractError := *ErrContractError
+		contractError.data = decodeErrorData[ContractErrorData](nodeErr.data)
+		return &contractError
+	case ErrTxnExec.code:
+		txnExecErr := *ErrTxnExec
+		txnExecErr.data = decodeErrorData[TxnExecutionErrorData](nodeErr.data)
+		return &txnExecErr
+	case ErrCompilationFailed.code:
+		compilationErr := *ErrCompilationFailed
+		compilationErr.data = decodeErrorData[CompilationFailedData](nodeErr.data)
+		return &compilationErr
+	}
+	return nil
+}
+
+// decodeErrorData re-decodes an already-unmarshalled `any` (typically a
+// map[string]any from the generic first pass) into its typed data variant.
+// Node errors whose data doesn't match the expected shape decode to the
+// zero value rather than failing tryUnwrapToRPCErr outright.
+func decodeErrorData[T any](data any) T {
+	var typed T
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return typed
+	}
+	_ = json.Unmarshal(raw, &typed)
+	return typed
+}
+
+// ContractErrorData is the `data` field of an ErrContractError.
+type ContractErrorData struct {
+	RevertError string `json:"revert_error"`
+}
+
+// TxnExecutionErrorData is the `data` field of an ErrTxnExec.
+type TxnExecutionErrorData struct {
+	TransactionIndex int    `json:"transaction_index"`
+	ExecutionError   string `json:"execution_error"`
+}
+
+// UnexpectedErrorData is the `data` field of an ErrUnexpectedError.
+type UnexpectedErrorData struct {
+	Reason string `json:"reason"`
+}
+
+// CompilationFailedData is the `data` field of an ErrCompilationFailed.
+type CompilationFailedData struct {
+	CompilationError string `json:"compilation_error"`
+}
+
+// NoTraceAvailableData is the `data` field of an ErrNoTraceAvailable.
+type NoTraceAvailableData struct {
+	Status string `json:"status"`
+}
+
+type RPCError struct {
+	code    int
+	message string
+	data    any
+}
+
+// Error returns the error message associated with the RPCError.
+//
+// Parameters:
+// - none
+// Returns:
+// - string: the error message
+func (e *RPCError) Error() string {
+	return e.message
+}
+
+// Code returns the code of the RPCError.
+//
+// Parameters:
+//
+//	none
+//
+// Returns:
+//   - int: the code
+func (e *RPCError) Code() int {
+	return e.code
+}
+
+// Data returns the data associated with the RPCError.
+//
+// Parameters:
+//
+//	none
+//
+// Returns:
+//   - any: the data
+func (e *RPCError) Data() any {
+	return e.data
+}
+
+// RevertError returns the contract revert reason carried by an
+// ErrContractError's data, or "" if this error isn't an ErrContractError.
+//
+// Parameters:
+// - none
+// Returns:
+// - string: the revert reason
+func (e *RPCError) RevertError() string {
+	if data, ok := e.data.(ContractErrorData); ok {
+		return data.RevertError
+	}
+	return ""
+}
+
+// ExecutionError returns the execution failure reason carried by an
+// ErrTxnExec's data, or "" if this error isn't an ErrTxnExec.
+//
+// Parameters:
+// - none
+// Returns:
+// - string: the execution error
+func (e *RPCError) ExecutionError() string {
+	if data, ok := e.data.(TxnExecutionErrorData); ok {
+		return data.ExecutionError
+	}
+	return ""
+}
+
+// CompilationError returns the compilation failure reason carried by an
+// ErrCompilationFailed's data, or "" if this error isn't an ErrCompilationFailed.
+//
+// Parameters:
+// - none
+// Returns:
+// - string: the compilation error
+func (e *RPCError) CompilationError() string {
+	if data, ok := e.data.(CompilationFailedData); ok {
+		return data.CompilationError
+	}
+	return ""
+}
+
+// MarshalJSON implements json.Marshaler so an RPCError (including a nested
+// node error carried as its data) round-trips through JSON without losing
+// its code/message/data.
+func (e *RPCError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    any    `json:"data,omitempty"`
+	}{Code: e.code, Message: e.message, Data: e.data})
+}
+
+// UnmarshalJSON implements json.Unmarshaler so an RPCError can be decoded
+// straight off a JSON-RPC error object, with Data left as the generic `any`
+// the caller should pass through isErrorWithData/decodeErrorData for typing.
+func (e *RPCError) UnmarshalJSON(b []byte) error {
+	var wire struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    any    `json:"data,omitempty"`
+	}
+	if err := json.Unmarshal(b, &wire); err != nil {
+		return err
+	}
+	e.code = wire.Code
+	e.message = wire.Message
+	e.data = wire.Data
+	return nil
+}
+
+var (
+	ErrFailedToReceiveTxn = &RPCError{
+		code:    1,
+		message: "Failed to write transaction",
+	}
+	ErrNoTraceAvailable = &RPCError{
+		code:    10,
+		message: "No trace available for transaction",
+	}
+	ErrContractNotFound = &RPCError{
+		code:    20,
+		message: "Contract not found",
+	}
+	ErrBlockNotFound = &RPCError{
+		code:    24,
+		message: "Block not found",
+	}
+	ErrInvalidTxnHash = &RPCError{
+		code:    25,
+		message: "Invalid transaction hash",
+	}
+	ErrInvalidBlockHash = &RPCError{
+		code:    26,
+		message: "Invalid block hash",
+	}
+	ErrInvalidTxnIndex = &RPCError{
+		code:    27,
+		message: "Invalid transaction index in a block",
+	}
+	ErrClassHashNotFound = &RPCError{
+		code:    28,
+		message: "Class hash not found",
+	}
+	ErrHashNotFound = &RPCError{
+		code:    29,
+		message: "Transaction hash not found",
+	}
+	ErrPageSizeTooBig = &RPCError{
+		code:    31,
+		message: "Requested page size is too big",
+	}
+	ErrNoBlocks = &RPCError{
+		code:    32,
+		message: "There are no blocks",
+	}
+	ErrInvalidContinuationToken = &RPCError{
+		code:    33,
+		message: "The supplied continuation token is invalid or unknown",
+	}
+	ErrTooManyKeysInFilter = &RPCError{
+		code:    34,
+		message: "Too many keys provided in a filter",
+	}
+	ErrContractError = &RPCError{
+		code:    40,
+		message: "Contract error",
+	}
+	ErrTxnExec = &RPCError{
+		code:    41,
+		message: "Transaction execution error",
+	}
+	ErrInvalidContractClass = &RPCError{
+		code:    50,
+		message: "Invalid contract class",
+	}
+	ErrClassAlreadyDeclared = &RPCError{
+		code:    51,
+		message: "Class already declared",
+	}
+	ErrInvalidTransactionNonce = &RPCError{
+		code:    52,
+		message: "Invalid transaction nonce",
+	}
+	ErrInsufficientMaxFee = &RPCError{
+		code:    53,
+		message: "Max fee is smaller than the minimal transaction cost (validation plus fee transfer)",
+	}
+	ErrInsufficientAccountBalance = &RPCError{
+		code:    54,
+		message: "Account balance is smaller than the transaction's max_fee",
+	}
+	ErrValidationFailure = &RPCError{
+		code:    55,
+		message: "Account validation failed",
+	}
+	ErrCompilationFailed = &RPCError{
+		code:    56,
+		message: "Compilation failed",
+	}
+	ErrContractClassSizeTooLarge = &RPCError{
+		code:    57,
+		message: "Contract class size is too large",
+	}
+	ErrNonAccount = &RPCError{
+		code:    58,
+		message: "Sender address is not an account contract",
+	}
+	ErrDuplicateTx = &RPCError{
+		code:    59,
+		message: "A transaction with the same hash already exists in the mempool",
+	}
+	ErrCompiledClassHashMismatch = &RPCError{
+		code:    60,
+		message: "The compiled class hash did not match the one supplied in the transaction",
+	}
+	ErrUnsupportedTxVersion = &RPCError{
+		code:    61,
+		message: "The transaction version is not supported",
+	}
+	ErrUnsupportedContractClassVersion = &RPCError{
+		code:    62,
+		message: "The contract class version is not supported",
+	}
+	ErrUnexpectedError = &RPCError{
+		code:    63,
+		message: "An unexpected error occurred",
+	}
+)
@@ -0,0 +1,90 @@
+package errors
+
+import (
+	goerrors "errors"
+	"testing"
+
+	"github.com/test-go/testify/require"
+)
+
+// TestTryUnwrapToRPCErrUnwrapsNodeError tests that TryUnwrapToRPCErr
+// correctly unmarshals a node's JSON-RPC error body instead of failing on
+// a nil *RPCError as it previously did.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestTryUnwrapToRPCErrUnwrapsNodeError(t *testing.T) {
+	nodeErr := goerrors.New(`{"code":20,"message":"Contract not found"}`)
+
+	result := TryUnwrapToRPCErr(nodeErr, ErrContractNotFound)
+
+	rpcErr, ok := result.(*RPCError)
+	require.True(t, ok)
+	require.Equal(t, ErrContractNotFound.Code(), rpcErr.Code())
+}
+
+// TestIsErrorWithDataDecodesTypedVariants tests that each error code whose
+// typed data payload has an accessor (contract error, transaction execution
+// error, compilation failure) round-trips into its matching struct and is
+// reachable through that accessor.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestIsErrorWithDataDecodesTypedVariants(t *testing.T) {
+	testSet := []struct {
+		NodeErr       *RPCError
+		ExpectedCheck func(t *testing.T, got *RPCError)
+	}{
+		{
+			NodeErr: &RPCError{code: ErrContractError.code, data: map[string]any{"revert_error": "boom"}},
+			ExpectedCheck: func(t *testing.T, got *RPCError) {
+				require.Equal(t, "boom", got.RevertError())
+			},
+		},
+		{
+			NodeErr: &RPCError{code: ErrTxnExec.code, data: map[string]any{"transaction_index": float64(2), "execution_error": "bad"}},
+			ExpectedCheck: func(t *testing.T, got *RPCError) {
+				require.Equal(t, "bad", got.ExecutionError())
+			},
+		},
+		{
+			NodeErr: &RPCError{code: ErrCompilationFailed.code, data: map[string]any{"compilation_error": "syntax"}},
+			ExpectedCheck: func(t *testing.T, got *RPCError) {
+				require.Equal(t, "syntax", got.CompilationError())
+			},
+		},
+	}
+
+	for _, test := range testSet {
+		got := IsErrorWithData(test.NodeErr)
+		require.NotNil(t, got)
+		test.ExpectedCheck(t, got)
+	}
+}
+
+// TestRPCErrorJSONRoundTrip tests that an RPCError's MarshalJSON/UnmarshalJSON
+// pair preserves its code, message, and data.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestRPCErrorJSONRoundTrip(t *testing.T) {
+	original := &RPCError{code: 40, message: "Contract error", data: map[string]any{"revert_error": "boom"}}
+
+	encoded, err := original.MarshalJSON()
+	require.Nil(t, err)
+
+	var decoded RPCError
+	require.Nil(t, decoded.UnmarshalJSON(encoded))
+	require.Equal(t, original.code, decoded.code)
+	require.Equal(t, original.message, decoded.message)
+	require.Equal(t, original.data, decoded.data)
+}
@@ -0,0 +1,60 @@
+package rpc
+
+import "github.com/NethermindEth/starknet.go/rpc/errors"
+
+// The RPCError type and the sentinel Err* values used to live directly in
+// this package; they now live in rpc/errors so downstream consumers can
+// depend on just the error surface without pulling in the HTTP transport.
+// These aliases keep existing call sites (and this package's own tests)
+// compiling unchanged.
+type RPCError = errors.RPCError
+
+const (
+	InvalidJSON    = errors.InvalidJSON
+	InvalidRequest = errors.InvalidRequest
+	MethodNotFound = errors.MethodNotFound
+	InvalidParams  = errors.InvalidParams
+	InternalError  = errors.InternalError
+)
+
+var (
+	ErrNotImplemented                 = errors.ErrNotImplemented
+	Err                                = errors.Err
+	ErrFailedToReceiveTxn              = errors.ErrFailedToReceiveTxn
+	ErrNoTraceAvailable                = errors.ErrNoTraceAvailable
+	ErrContractNotFound                = errors.ErrContractNotFound
+	ErrBlockNotFound                   = errors.ErrBlockNotFound
+	ErrInvalidTxnHash                  = errors.ErrInvalidTxnHash
+	ErrInvalidBlockHash                = errors.ErrInvalidBlockHash
+	ErrInvalidTxnIndex                 = errors.ErrInvalidTxnIndex
+	ErrClassHashNotFound               = errors.ErrClassHashNotFound
+	ErrHashNotFound                    = errors.ErrHashNotFound
+	ErrPageSizeTooBig                  = errors.ErrPageSizeTooBig
+	ErrNoBlocks                        = errors.ErrNoBlocks
+	ErrInvalidContinuationToken        = errors.ErrInvalidContinuationToken
+	ErrTooManyKeysInFilter             = errors.ErrTooManyKeysInFilter
+	ErrContractError                   = errors.ErrContractError
+	ErrTxnExec                         = errors.ErrTxnExec
+	ErrInvalidContractClass            = errors.ErrInvalidContractClass
+	ErrClassAlreadyDeclared            = errors.ErrClassAlreadyDeclared
+	ErrInvalidTransactionNonce         = errors.ErrInvalidTransactionNonce
+	ErrInsufficientMaxFee              = errors.ErrInsufficientMaxFee
+	ErrInsufficientAccountBalance      = errors.ErrInsufficientAccountBalance
+	ErrValidationFailure               = errors.ErrValidationFailure
+	ErrCompilationFailed               = errors.ErrCompilationFailed
+	ErrContractClassSizeTooLarge       = errors.ErrContractClassSizeTooLarge
+	ErrNonAccount                      = errors.ErrNonAccount
+	ErrDuplicateTx                     = errors.ErrDuplicateTx
+	ErrCompiledClassHashMismatch       = errors.ErrCompiledClassHashMismatch
+	ErrUnsupportedTxVersion            = errors.ErrUnsupportedTxVersion
+	ErrUnsupportedContractClassVersion = errors.ErrUnsupportedContractClassVersion
+	ErrUnexpectedError                 = errors.ErrUnexpectedError
+)
+
+// tryUnwrapToRPCErr and isErrorWithData keep living under these names inside
+// package rpc (unexported, matching the pre-split call sites) even though
+// their implementations now live in rpc/errors.
+var (
+	tryUnwrapToRPCErr = errors.TryUnwrapToRPCErr
+	isErrorWithData   = errors.IsErrorWithData
+)
@@ -0,0 +1,520 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NethermindEth/juno/core/felt"
+	"github.com/gorilla/websocket"
+)
+
+// Subscriber is a websocket-backed client for the Starknet JSON-RPC 0.7+
+// streaming methods (starknet_subscribeNewHeads, starknet_subscribeEvents,
+// starknet_subscribePendingTransactions, starknet_subscribeTransactionStatus).
+// Unlike Provider, which issues one request per call over HTTP, a Subscriber
+// keeps a single websocket connection open and multiplexes every
+// notification it receives by subscription_id to the channel the matching
+// Subscribe* call returned.
+type Subscriber struct {
+	url string
+
+	// writeMu serializes every write to conn: gorilla/websocket forbids
+	// concurrent writers, and concurrent Subscribe*/Unsubscribe/call
+	// invocations would otherwise race on the same *websocket.Conn.
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	nextID  uint64
+	pending map[uint64]chan json.RawMessage // request id -> response channel, for subscribe/unsubscribe acks
+	subs    map[string]*subscription        // subscription_id -> live subscription
+	closed  bool
+}
+
+type subscription struct {
+	method  string // the starknet_subscribe* method, replayed on reconnect
+	params  any    // the params this subscription was created with, replayed on reconnect
+	deliver func(json.RawMessage)
+	errCh   chan error
+}
+
+// Subscription represents one active streaming subscription. Callers read
+// from the typed channel returned alongside it and from Err() to learn about
+// reorgs or transport failures; Unsubscribe tears it down.
+type Subscription struct {
+	id         string
+	subscriber *Subscriber
+	errCh      chan error
+}
+
+// Err returns a channel that reports subscription failures: a reorg
+// notification from the node, a notification that fails to decode, or a
+// resubscribe that fails after a transport-level reconnect. It buffers one
+// error; further errors are dropped while one is unread. The channel is
+// closed by Unsubscribe or Subscriber.Close.
+//
+// Parameters:
+// - none
+// Returns:
+// - <-chan error: the subscription's error channel
+func (s *Subscription) Err() <-chan error {
+	return s.errCh
+}
+
+// Unsubscribe sends starknet_unsubscribe for this subscription and stops
+// delivering further notifications to its channel.
+//
+// Parameters:
+// - ctx: the context
+// Returns:
+// - error: an error, if any
+func (s *Subscription) Unsubscribe(ctx context.Context) error {
+	return s.subscriber.unsubscribe(ctx, s.id)
+}
+
+// NewSubscriber dials url and returns a Subscriber ready to accept
+// Subscribe* calls. It starts a background goroutine that reads
+// notifications off the socket and reconnects (re-establishing every live
+// subscription) on transport failure.
+//
+// Parameters:
+// - ctx: the context used for the initial dial
+// - url: the websocket endpoint, e.g. "wss://.../rpc/v0_7"
+// Returns:
+// - *Subscriber: the connected subscriber
+// - error: an error, if the initial dial fails
+func NewSubscriber(ctx context.Context, url string) (*Subscriber, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Subscriber{
+		url:     url,
+		conn:    conn,
+		pending: make(map[uint64]chan json.RawMessage),
+		subs:    make(map[string]*subscription),
+	}
+	go s.readLoop()
+	return s, nil
+}
+
+// Close tears down the underlying websocket connection and every active
+// subscription's error channel.
+//
+// Parameters:
+// - none
+// Returns:
+// - error: an error, if any
+func (s *Subscriber) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	for _, sub := range s.subs {
+		close(sub.errCh)
+	}
+	return s.conn.Close()
+}
+
+type subscribeNotification struct {
+	Method string `json:"method"`
+	Params struct {
+		SubscriptionID string          `json:"subscription_id"`
+		Result         json.RawMessage `json:"result"`
+	} `json:"params"`
+}
+
+type rpcResponseEnvelope struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error,omitempty"`
+}
+
+// readLoop reads every message off the socket, routing subscribe/unsubscribe
+// responses to their waiting caller and notifications to the matching live
+// subscription's deliver func. On a read error it reconnects and
+// resubscribes every still-open subscription, retrying a failed redial with
+// exponential backoff capped at maxBackoff.
+func (s *Subscriber) readLoop() {
+	backoff := 250 * time.Millisecond
+	const maxBackoff = 10 * time.Second
+
+	for {
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			s.mu.Lock()
+			if s.closed {
+				s.mu.Unlock()
+				return
+			}
+			s.mu.Unlock()
+
+			if reconnErr := s.reconnect(); reconnErr != nil {
+				time.Sleep(backoff)
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+				continue
+			}
+			backoff = 250 * time.Millisecond
+			continue
+		}
+
+		s.dispatch(message)
+	}
+}
+
+// dispatch routes a single raw websocket message to either the live
+// subscription it notifies or the pending request/response channel it
+// answers. Split out of readLoop so the multiplexing logic can be
+// exercised directly in tests without a real socket.
+func (s *Subscriber) dispatch(message []byte) {
+	var notif subscribeNotification
+	if json.Unmarshal(message, &notif) == nil && notif.Params.SubscriptionID != "" {
+		s.mu.Lock()
+		sub, ok := s.subs[notif.Params.SubscriptionID]
+		s.mu.Unlock()
+		if ok {
+			if notif.Method == "starknet_subscriptionReorg" {
+				s.deliverFatal(sub, fmt.Errorf("rpc: reorg notification for subscription %s: %s", notif.Params.SubscriptionID, notif.Params.Result))
+				return
+			}
+			sub.deliver(notif.Params.Result)
+		}
+		return
+	}
+
+	var resp rpcResponseEnvelope
+	if json.Unmarshal(message, &resp) == nil && resp.ID != 0 {
+		s.mu.Lock()
+		ch, ok := s.pending[resp.ID]
+		s.mu.Unlock()
+		if ok {
+			ch <- resp.Result
+		}
+	}
+}
+
+// reconnect re-dials s.url, then resubscribes every subscription that was
+// still open by replaying its original starknet_subscribe* call. Each
+// subscription's node-assigned subscription_id changes across the
+// reconnect, so subs is rekeyed to the new id under the same deliver/errCh.
+// A subscription whose resubscribe fails is dropped and gets a final error
+// on its Err() channel instead of silently going dead.
+//
+// reconnect runs on the readLoop goroutine, the only goroutine that ever
+// reads conn -- so it cannot resubscribe via call(), which blocks waiting for
+// readLoop to hand it the response through dispatch() and would deadlock
+// forever against itself. Instead it reads the new conn directly, via
+// resubscribeOnConn, until its own response arrives.
+func (s *Subscriber) reconnect() error {
+	conn, _, err := websocket.DefaultDialer.Dial(s.url, nil)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.conn = conn
+	live := make(map[string]*subscription, len(s.subs))
+	for id, sub := range s.subs {
+		live[id] = sub
+	}
+	s.subs = make(map[string]*subscription)
+	s.mu.Unlock()
+
+	for _, sub := range live {
+		subscriptionID, callErr := s.resubscribeOnConn(conn, sub.method, sub.params)
+		if callErr != nil {
+			s.deliverFatal(sub, fmt.Errorf("rpc: resubscribing %s after reconnect: %w", sub.method, callErr))
+			continue
+		}
+
+		s.mu.Lock()
+		s.subs[subscriptionID] = sub
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// resubscribeOnConn issues a single starknet_subscribe* call directly against
+// conn and blocks reading conn itself until the matching response arrives,
+// rather than going through call()/pending+dispatch (which depend on
+// readLoop, the very goroutine reconnect runs on). Any other message read
+// along the way -- a notification for an already-resubscribed subscription,
+// say -- is routed through dispatch() as usual so it isn't dropped.
+func (s *Subscriber) resubscribeOnConn(conn *websocket.Conn, method string, params any) (string, error) {
+	s.mu.Lock()
+	s.nextID++
+	id := s.nextID
+	s.mu.Unlock()
+
+	req := map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+
+	s.writeMu.Lock()
+	writeErr := conn.WriteJSON(req)
+	s.writeMu.Unlock()
+	if writeErr != nil {
+		return "", writeErr
+	}
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return "", err
+		}
+
+		var resp rpcResponseEnvelope
+		if json.Unmarshal(message, &resp) != nil || resp.ID != id {
+			s.dispatch(message)
+			continue
+		}
+		if resp.Error != nil {
+			return "", resp.Error
+		}
+		var idResult subscriptionIDResult
+		if err := json.Unmarshal(resp.Result, &idResult); err != nil {
+			return "", err
+		}
+		return idResult.SubscriptionID, nil
+	}
+}
+
+// deliverFatal pushes a single terminal error to sub's Err() channel. Used
+// when a subscription can't be carried across a reconnect.
+func (s *Subscriber) deliverFatal(sub *subscription, err error) {
+	select {
+	case sub.errCh <- err:
+	default:
+	}
+}
+
+func (s *Subscriber) call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	s.mu.Lock()
+	s.nextID++
+	id := s.nextID
+	ch := make(chan json.RawMessage, 1)
+	s.pending[id] = ch
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
+	}()
+
+	req := map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+
+	s.writeMu.Lock()
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+	writeErr := conn.WriteJSON(req)
+	s.writeMu.Unlock()
+	if writeErr != nil {
+		return nil, writeErr
+	}
+
+	select {
+	case result := <-ch:
+		return result, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *Subscriber) unsubscribe(ctx context.Context, id string) error {
+	s.mu.Lock()
+	sub, ok := s.subs[id]
+	delete(s.subs, id)
+	s.mu.Unlock()
+	if ok {
+		close(sub.errCh)
+	}
+	_, err := s.call(ctx, "starknet_unsubscribe", []any{id})
+	return err
+}
+
+func (s *Subscriber) register(id, method string, params any, errCh chan error, deliver func(json.RawMessage)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs[id] = &subscription{method: method, params: params, deliver: deliver, errCh: errCh}
+}
+
+// subscriptionIDResult is the shape of a successful Subscribe* response.
+type subscriptionIDResult struct {
+	SubscriptionID string `json:"subscription_id"`
+}
+
+// EventSubscriptionInput filters the events starknet_subscribeEvents streams.
+type EventSubscriptionInput struct {
+	FromAddress *felt.Felt   `json:"from_address,omitempty"`
+	Keys        [][]*felt.Felt `json:"keys,omitempty"`
+	BlockID     *BlockID     `json:"block_id,omitempty"`
+}
+
+// SubscribeEvents subscribes to starknet_subscribeEvents, optionally
+// filtered by emitting contract, event keys, and starting block.
+//
+// Parameters:
+// - ctx: the context
+// - filter: the subscription filter; zero value subscribes to every event
+// Returns:
+// - <-chan *EmittedEvent: a channel of matching events as they're emitted
+// - *Subscription: handle to unsubscribe or observe transport errors
+// - error: an error, if the subscribe call fails
+func (s *Subscriber) SubscribeEvents(ctx context.Context, filter EventSubscriptionInput) (<-chan *EmittedEvent, *Subscription, error) {
+	method, params := "starknet_subscribeEvents", []any{filter}
+	raw, err := s.call(ctx, method, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idResult subscriptionIDResult
+	if err := json.Unmarshal(raw, &idResult); err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan *EmittedEvent, 64)
+	errCh := make(chan error, 1)
+	s.register(idResult.SubscriptionID, method, params, errCh, func(result json.RawMessage) {
+		var event EmittedEvent
+		if err := json.Unmarshal(result, &event); err != nil {
+			select {
+			case errCh <- fmt.Errorf("rpc: decoding EmittedEvent: %w", err):
+			default:
+			}
+			return
+		}
+		events <- &event
+	})
+
+	return events, &Subscription{id: idResult.SubscriptionID, subscriber: s, errCh: errCh}, nil
+}
+
+// PendingTxnSubscriptionInput filters starknet_subscribePendingTransactions.
+type PendingTxnSubscriptionInput struct {
+	TransactionDetails bool       `json:"transaction_details,omitempty"`
+	SenderAddress      *felt.Felt `json:"sender_address,omitempty"`
+}
+
+// SubscribePendingTransactions subscribes to starknet_subscribePendingTransactions.
+//
+// Parameters:
+// - ctx: the context
+// - filter: controls whether full transaction details are sent, and restricts to a sender
+// Returns:
+// - <-chan Transaction: a channel of pending transactions as they're seen
+// - *Subscription: handle to unsubscribe or observe transport errors
+// - error: an error, if the subscribe call fails
+func (s *Subscriber) SubscribePendingTransactions(ctx context.Context, filter PendingTxnSubscriptionInput) (<-chan Transaction, *Subscription, error) {
+	method, params := "starknet_subscribePendingTransactions", []any{filter}
+	raw, err := s.call(ctx, method, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idResult subscriptionIDResult
+	if err := json.Unmarshal(raw, &idResult); err != nil {
+		return nil, nil, err
+	}
+
+	txns := make(chan Transaction, 64)
+	errCh := make(chan error, 1)
+	s.register(idResult.SubscriptionID, method, params, errCh, func(result json.RawMessage) {
+		txn, err := unmarshalTxn(result)
+		if err != nil {
+			select {
+			case errCh <- fmt.Errorf("rpc: decoding pending transaction: %w", err):
+			default:
+			}
+			return
+		}
+		txns <- txn
+	})
+
+	return txns, &Subscription{id: idResult.SubscriptionID, subscriber: s, errCh: errCh}, nil
+}
+
+// SubscribeTransactionStatus subscribes to starknet_subscribeTransactionStatus
+// for a single transaction hash.
+//
+// Parameters:
+// - ctx: the context
+// - txHash: the transaction hash to track
+// Returns:
+// - <-chan TxnStatusResp: a channel of status updates for txHash
+// - *Subscription: handle to unsubscribe or observe transport errors
+// - error: an error, if the subscribe call fails
+func (s *Subscriber) SubscribeTransactionStatus(ctx context.Context, txHash *felt.Felt) (<-chan TxnStatusResp, *Subscription, error) {
+	method, params := "starknet_subscribeTransactionStatus", []any{txHash}
+	raw, err := s.call(ctx, method, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idResult subscriptionIDResult
+	if err := json.Unmarshal(raw, &idResult); err != nil {
+		return nil, nil, err
+	}
+
+	statuses := make(chan TxnStatusResp, 8)
+	errCh := make(chan error, 1)
+	s.register(idResult.SubscriptionID, method, params, errCh, func(result json.RawMessage) {
+		var status TxnStatusResp
+		if err := json.Unmarshal(result, &status); err != nil {
+			select {
+			case errCh <- fmt.Errorf("rpc: decoding TxnStatusResp: %w", err):
+			default:
+			}
+			return
+		}
+		statuses <- status
+	})
+
+	return statuses, &Subscription{id: idResult.SubscriptionID, subscriber: s, errCh: errCh}, nil
+}
+
+// SubscribeNewHeads subscribes to starknet_subscribeNewHeads, optionally
+// starting from a historical block instead of the chain head.
+//
+// Parameters:
+// - ctx: the context
+// - blockID: the block to start streaming from; the zero value starts at the current head
+// Returns:
+// - <-chan *BlockHeader: a channel of block headers as they're produced
+// - *Subscription: handle to unsubscribe or observe transport errors
+// - error: an error, if the subscribe call fails
+func (s *Subscriber) SubscribeNewHeads(ctx context.Context, blockID BlockID) (<-chan *BlockHeader, *Subscription, error) {
+	method, params := "starknet_subscribeNewHeads", []any{blockID}
+	raw, err := s.call(ctx, method, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	var idResult subscriptionIDResult
+	if err := json.Unmarshal(raw, &idResult); err != nil {
+		return nil, nil, err
+	}
+
+	heads := make(chan *BlockHeader, 16)
+	errCh := make(chan error, 1)
+	s.register(idResult.SubscriptionID, method, params, errCh, func(result json.RawMessage) {
+		var header BlockHeader
+		if err := json.Unmarshal(result, &header); err != nil {
+			select {
+			case errCh <- fmt.Errorf("rpc: decoding BlockHeader: %w", err):
+			default:
+			}
+			return
+		}
+		heads <- &header
+	})
+
+	return heads, &Subscription{id: idResult.SubscriptionID, subscriber: s, errCh: errCh}, nil
+}
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/test-go/testify/require"
+)
+
+// TestSubscriberDispatchRoutesToSubscription tests that a notification
+// bearing a subscription_id is delivered to the matching registered
+// subscription and nowhere else.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestSubscriberDispatchRoutesToSubscription(t *testing.T) {
+	s := &Subscriber{
+		pending: make(map[uint64]chan json.RawMessage),
+		subs:    make(map[string]*subscription),
+	}
+
+	delivered := make(chan json.RawMessage, 1)
+	s.register("sub-1", "starknet_subscribeNewHeads", []any{BlockID{}}, make(chan error, 1), func(result json.RawMessage) {
+		delivered <- result
+	})
+
+	message := []byte(`{"jsonrpc":"2.0","method":"starknet_subscriptionNewHeads","params":{"subscription_id":"sub-1","result":{"block_number":42}}}`)
+	s.dispatch(message)
+
+	select {
+	case result := <-delivered:
+		require.JSONEq(t, `{"block_number":42}`, string(result))
+	case <-time.After(time.Second):
+		t.Fatal("expected notification to be delivered")
+	}
+}
+
+// TestSubscriberDispatchRoutesToPendingRequest tests that a regular
+// JSON-RPC response (keyed by id, not subscription_id) is routed to the
+// pending channel registered for that id.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestSubscriberDispatchRoutesToPendingRequest(t *testing.T) {
+	s := &Subscriber{
+		pending: make(map[uint64]chan json.RawMessage),
+		subs:    make(map[string]*subscription),
+	}
+
+	ch := make(chan json.RawMessage, 1)
+	s.pending[7] = ch
+
+	message := []byte(`{"jsonrpc":"2.0","id":7,"result":{"subscription_id":"sub-9"}}`)
+	s.dispatch(message)
+
+	select {
+	case result := <-ch:
+		require.JSONEq(t, `{"subscription_id":"sub-9"}`, string(result))
+	case <-time.After(time.Second):
+		t.Fatal("expected response to be delivered to the pending channel")
+	}
+}
+
+// TestSubscriptionUnsubscribeClosesErrChannel tests that unsubscribing
+// removes the subscription from the multiplexer and closes its error
+// channel, so callers ranging over Err() terminate.
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestSubscriptionUnsubscribeClosesErrChannel(t *testing.T) {
+	var upgrader websocket.Upgrader
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.Nil(t, err)
+		defer conn.Close()
+
+		// Ack the starknet_unsubscribe request, then hold the connection
+		// open until the client closes it.
+		var req map[string]any
+		require.Nil(t, conn.ReadJSON(&req))
+		require.Equal(t, "starknet_unsubscribe", req["method"])
+		require.Nil(t, conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": req["id"], "result": true}))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	s, err := NewSubscriber(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"))
+	require.Nil(t, err)
+	defer s.Close()
+
+	errCh := make(chan error, 1)
+	s.register("sub-1", "starknet_subscribeNewHeads", []any{BlockID{}}, errCh, func(json.RawMessage) {})
+
+	sub := &Subscription{id: "sub-1", subscriber: s, errCh: errCh}
+	require.Nil(t, sub.Unsubscribe(context.Background()))
+
+	s.mu.Lock()
+	_, ok := s.subs["sub-1"]
+	s.mu.Unlock()
+	require.False(t, ok)
+
+	_, open := <-errCh
+	require.False(t, open)
+}
+
+// TestSubscriberReconnectResubscribesOverFakeSocket tests reconnect() end to
+// end against a real (fake) websocket server: the server acks the initial
+// subscribe then drops the connection, and the test asserts the client
+// notices, redials, replays the subscribe under a new subscription_id, and
+// still delivers the next notification -- the whole point of this package's
+// resubscribe-on-reconnect behaviour, which previously deadlocked the first
+// time any subscription reconnected (reconnect() called call(), which blocks
+// on the very readLoop goroutine it runs on).
+//
+// Parameters:
+// - t: the testing object for running the test cases
+// Returns:
+//
+//	none
+func TestSubscriberReconnectResubscribesOverFakeSocket(t *testing.T) {
+	var upgrader websocket.Upgrader
+	var connCount int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.Nil(t, err)
+		defer conn.Close()
+
+		var req map[string]any
+		require.Nil(t, conn.ReadJSON(&req))
+
+		switch atomic.AddInt32(&connCount, 1) {
+		case 1:
+			// Ack the initial subscribe, then drop the connection with no
+			// further traffic -- the client's readLoop should notice and
+			// reconnect.
+			require.Nil(t, conn.WriteJSON(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      req["id"],
+				"result":  map[string]string{"subscription_id": "sub-1"},
+			}))
+		case 2:
+			// This is the resubscribe reconnect() issues after redialing; ack
+			// it under a new subscription_id and push one notification under
+			// that id.
+			require.Nil(t, conn.WriteJSON(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      req["id"],
+				"result":  map[string]string{"subscription_id": "sub-2"},
+			}))
+			require.Nil(t, conn.WriteJSON(map[string]any{
+				"jsonrpc": "2.0",
+				"method":  "starknet_subscriptionNewHeads",
+				"params": map[string]any{
+					"subscription_id": "sub-2",
+					"result":          map[string]int{"block_number": 7},
+				},
+			}))
+			time.Sleep(time.Second)
+		}
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	s, err := NewSubscriber(context.Background(), wsURL)
+	require.Nil(t, err)
+	defer s.Close()
+
+	heads, sub, err := s.SubscribeNewHeads(context.Background(), BlockID{})
+	require.Nil(t, err)
+	require.Equal(t, "sub-1", sub.id)
+
+	select {
+	case header := <-heads:
+		require.NotNil(t, header)
+	case subErr := <-sub.Err():
+		t.Fatalf("subscription errored instead of surviving the reconnect: %v", subErr)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a notification after reconnect/resubscribe")
+	}
+}
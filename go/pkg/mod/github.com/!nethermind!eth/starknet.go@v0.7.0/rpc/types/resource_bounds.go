@@ -0,0 +1,26 @@
+// Package types holds the request/response DTOs shared by the Starknet
+// JSON-RPC methods, independent of both the transport (rpc/client) and the
+// error surface (rpc/errors), so downstream consumers can depend on just the
+// wire shapes.
+//
+// This package currently only carries the DTOs this codebase itself defines
+// (ResourceBounds/ResourceBoundsMapping). The bulk of the RPC DTO surface
+// referenced by this package's tests — Transaction, TxnStatusResp, BlockID,
+// FeePayment, ExecutionResources, InvokeTxnV1, and friends — is defined
+// upstream of this source tree and isn't present here to move; they stay
+// wherever the rest of the Provider surface already declares them.
+package types
+
+// ResourceBounds carries the v3 fee-market resource limits for one resource
+// kind (L1 gas or L2 gas).
+type ResourceBounds struct {
+	MaxAmount       string `json:"max_amount"`
+	MaxPricePerUnit string `json:"max_price_per_unit"`
+}
+
+// ResourceBoundsMapping is the `l1_gas`/`l2_gas` resource_bounds object
+// carried by every v3 transaction.
+type ResourceBoundsMapping struct {
+	L1Gas ResourceBounds `json:"l1_gas"`
+	L2Gas ResourceBounds `json:"l2_gas"`
+}
@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NethermindEth/juno/core/felt"
+)
+
+// ErrTransactionRejected is returned by WaitForTransactionReceipt when the
+// transaction reaches finality status REJECTED.
+var ErrTransactionRejected = fmt.Errorf("transaction rejected")
+
+// ErrTransactionReverted is returned by WaitForTransactionReceipt when the
+// transaction reaches execution status REVERTED. The node's failure reason,
+// if it provided one, is carried in ExecutionError; the *ErrTransactionReverted
+// value itself is retrievable via errors.As.
+type ErrTransactionReverted struct {
+	ExecutionError string
+}
+
+func (e *ErrTransactionReverted) Error() string {
+	return fmt.Sprintf("transaction reverted: %s", e.ExecutionError)
+}
+
+// WaitForTransactionReceipt polls starknet_getTransactionStatus for hash
+// every pollInterval, through the provider's configured retry/rate-limit
+// middleware, until the transaction's finality status reaches threshold (or
+// a terminal failure status), the context is cancelled, or a non-retryable
+// error occurs.
+//
+// Parameters:
+// - ctx: the context, used both to bound the whole poll loop and each underlying call
+// - provider: the provider to poll with
+// - hash: the transaction hash to wait for
+// - pollInterval: how often to poll
+// - threshold: the finality status that satisfies the wait, e.g. TxnStatus_Accepted_On_L2
+// Returns:
+// - *TxnStatusResp: the status once threshold is reached
+// - error: ErrTransactionRejected, *ErrTransactionReverted, or a transport error
+func WaitForTransactionReceipt(ctx context.Context, provider *Provider, hash *felt.Felt, pollInterval time.Duration, threshold TxnStatus) (*TxnStatusResp, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := provider.GetTransactionStatus(ctx, hash)
+		if err != nil {
+			if rpcErr, ok := err.(*RPCError); ok && rpcErr.Code() == ErrHashNotFound.Code() {
+				// Not indexed yet; keep polling.
+			} else {
+				return nil, err
+			}
+		} else {
+			switch status.FinalityStatus {
+			case TxnStatus_Rejected:
+				return status, ErrTransactionRejected
+			}
+			if status.ExecutionStatus == TxnExecutionStatusREVERTED {
+				return status, &ErrTransactionReverted{ExecutionError: status.FailureReason}
+			}
+			if finalityAtLeast(status.FinalityStatus, threshold) {
+				return status, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// finalityAtLeast orders finality statuses RECEIVED < ACCEPTED_ON_L2 < ACCEPTED_ON_L1
+// and reports whether got has reached at least want.
+func finalityAtLeast(got, want TxnStatus) bool {
+	rank := map[TxnStatus]int{
+		TxnStatus_Received:      0,
+		TxnStatus_Accepted_On_L2: 1,
+		TxnStatus_Accepted_On_L1: 2,
+	}
+	return rank[got] >= rank[want]
+}